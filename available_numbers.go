@@ -1,39 +1,77 @@
 package vtwilio
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
-	"strings"
+
+	"github.com/twiebe-va/vtwilio-go/phonenumber"
 )
 
 // AvailablePhoneNumbers finds an available phone number
 func (v *VTwilio) AvailablePhoneNumbers(countryCode string, opts ...AvailableOption) (*AvailablePhoneNumbers, error) {
-	config := &availableConfiguration{}
+	return v.availablePhoneNumbers(context.Background(), countryCode, opts...)
+}
+
+func (v *VTwilio) availablePhoneNumbers(ctx context.Context, countryCode string, opts ...AvailableOption) (*AvailablePhoneNumbers, error) {
+	config := &availableConfiguration{NumberType: LocalNumbers}
 	for _, o := range opts {
 		o(config)
 	}
+	if config.NearNumber != "" {
+		n, err := phonenumber.Normalize(config.NearNumber, countryCode)
+		if err != nil {
+			return nil, err
+		}
+		config.NearNumber = n
+	}
 
 	val := buildValues(config)
 
-	urlStr := fmt.Sprintf("%s%s%s/%s%s.json?%s", v.baseAPI, v.accountSID, availablePhoneNumbersAPI, countryCode, local, val)
+	urlStr := fmt.Sprintf("%s%s%s/%s/%s.json?%s", v.baseAPI, v.accountSID, availablePhoneNumbersAPI, countryCode, config.NumberType, val)
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleAvailability(req.WithContext(ctx))
+}
+
+// AvailableCountries lists the countries Twilio sells numbers in and the types of number each one offers
+func (v *VTwilio) AvailableCountries() (*AvailableCountries, error) {
+	urlStr := fmt.Sprintf("%s%s%s.json", v.baseAPI, v.accountSID, availablePhoneNumbersAPI)
 	req, err := http.NewRequest("GET", urlStr, nil)
 	if err != nil {
 		return nil, err
 	}
 	setUpRequest(req, v.accountSID, v.authToken)
-	return handleAvailability(req)
+	countries, err := handleAvailableCountries(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range countries.Countries {
+		for _, r := range numberTypeResources {
+			if _, ok := c.SubresourceURIs[r.resource]; ok {
+				c.NumberTypes = append(c.NumberTypes, r.numberType)
+			}
+		}
+	}
+	return countries, nil
 }
 
+// buildValues encodes the set string fields of c as a query string, keyed by field name
 func buildValues(c *availableConfiguration) string {
 	v := reflect.Indirect(reflect.ValueOf(c))
-	values := []string{}
+	values := url.Values{}
 	for i := 0; i < v.NumField(); i++ {
-		val := v.Field(i).Interface().(string)
-		if val == "" {
+		val, ok := v.Field(i).Interface().(string)
+		if val == "" || !ok {
 			continue
 		}
-		values = append(values, fmt.Sprintf("%s=%s", v.Type().Field(i).Name, val))
+		values.Set(v.Type().Field(i).Name, val)
 	}
-	return strings.Join(values, "&")
+	return values.Encode()
 }
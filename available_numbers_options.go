@@ -5,18 +5,71 @@ import (
 	"strings"
 )
 
+// NumberType is a kind of phone number Twilio sells
+type NumberType string
+
+const (
+	// LocalNumbers are geographic numbers
+	LocalNumbers NumberType = "Local"
+	// TollFreeNumbers are free for the caller
+	TollFreeNumbers NumberType = "TollFree"
+	// MobileNumbers are mobile numbers
+	MobileNumbers NumberType = "Mobile"
+	// NationalNumbers are non geographic numbers charged at the local rate
+	NationalNumbers NumberType = "National"
+	// SharedCostNumbers split the cost of a call between the caller and the receiver
+	SharedCostNumbers NumberType = "SharedCost"
+	// VoipNumbers are non geographic VoIP numbers
+	VoipNumbers NumberType = "Voip"
+	// MachineToMachineNumbers are for IoT devices
+	MachineToMachineNumbers NumberType = "MachineToMachine"
+)
+
+// numberTypeResources maps the subresource names Twilio uses for each number type
+var numberTypeResources = []struct {
+	resource   string
+	numberType NumberType
+}{
+	{"local", LocalNumbers},
+	{"toll_free", TollFreeNumbers},
+	{"mobile", MobileNumbers},
+	{"national", NationalNumbers},
+	{"shared_cost", SharedCostNumbers},
+	{"voip", VoipNumbers},
+	{"machine_to_machine", MachineToMachineNumbers},
+}
+
 // AvailableOption is an option for available phone numbers
 type AvailableOption func(*availableConfiguration)
 
 type availableConfiguration struct {
-	NearNumber   string
-	NearLatLong  string
-	Distance     string
-	InPostalCode string
-	InLocality   string
-	InRegion     string
-	InRateCenter string
-	InLata       string
+	NearNumber                    string
+	NearLatLong                   string
+	Distance                      string
+	InPostalCode                  string
+	InLocality                    string
+	InRegion                      string
+	InRateCenter                  string
+	InLata                        string
+	Contains                      string
+	AreaCode                      string
+	SmsEnabled                    string
+	MmsEnabled                    string
+	VoiceEnabled                  string
+	FaxEnabled                    string
+	ExcludeAllAddressRequired     string
+	ExcludeLocalAddressRequired   string
+	ExcludeForeignAddressRequired string
+	Beta                          string
+	PageSize                      string
+	NumberType                    NumberType
+}
+
+// AvailableNumberType sets the type of number to search for, defaults to LocalNumbers
+func AvailableNumberType(t NumberType) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.NumberType = t
+	}
 }
 
 // NearNumber Twilio Description:
@@ -90,3 +143,91 @@ func InLATA(l string) AvailableOption {
 		a.InLata = l
 	}
 }
+
+// Contains Twilio Description:
+// Find numbers that match a pattern. Use * to match any single digit, letters are matched to
+// their keypad digit e.g. "STORM" or "306***1234". See KeypadPattern.
+func Contains(pattern string) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.Contains = pattern
+	}
+}
+
+// InAreaCode Twilio Description:
+// Find numbers in an area code. (US and Canada only)
+func InAreaCode(code string) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.AreaCode = code
+	}
+}
+
+// SMSEnabled Twilio Description:
+// Whether the numbers can receive text messages.
+func SMSEnabled(e bool) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.SmsEnabled = strconv.FormatBool(e)
+	}
+}
+
+// MMSEnabled Twilio Description:
+// Whether the numbers can receive MMS messages.
+func MMSEnabled(e bool) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.MmsEnabled = strconv.FormatBool(e)
+	}
+}
+
+// VoiceEnabled Twilio Description:
+// Whether the numbers can receive calls.
+func VoiceEnabled(e bool) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.VoiceEnabled = strconv.FormatBool(e)
+	}
+}
+
+// FaxEnabled Twilio Description:
+// Whether the numbers can receive faxes.
+func FaxEnabled(e bool) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.FaxEnabled = strconv.FormatBool(e)
+	}
+}
+
+// ExcludeAllAddressRequired Twilio Description:
+// Whether to exclude numbers that require an address.
+func ExcludeAllAddressRequired(e bool) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.ExcludeAllAddressRequired = strconv.FormatBool(e)
+	}
+}
+
+// ExcludeLocalAddressRequired Twilio Description:
+// Whether to exclude numbers that require a local address.
+func ExcludeLocalAddressRequired(e bool) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.ExcludeLocalAddressRequired = strconv.FormatBool(e)
+	}
+}
+
+// ExcludeForeignAddressRequired Twilio Description:
+// Whether to exclude numbers that require a foreign address.
+func ExcludeForeignAddressRequired(e bool) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.ExcludeForeignAddressRequired = strconv.FormatBool(e)
+	}
+}
+
+// IncludeBeta Twilio Description:
+// Whether to read phone numbers that are new to the Twilio platform.
+func IncludeBeta(b bool) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.Beta = strconv.FormatBool(b)
+	}
+}
+
+// AvailablePageSize sets how many numbers are returned, Twilio defaults to 50
+func AvailablePageSize(size int) AvailableOption {
+	return func(a *availableConfiguration) {
+		a.PageSize = strconv.Itoa(size)
+	}
+}
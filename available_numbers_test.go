@@ -50,13 +50,13 @@ func TestAvailableNumbers(t *testing.T) {
 			name:          "near number",
 			in:            []AvailableOption{NearNumber("12345678910")},
 			expectedPath:  "/sid/AvailablePhoneNumbers/US/Local.json",
-			expectedQuery: "NearNumber=12345678910",
+			expectedQuery: "NearNumber=%2B12345678910",
 		},
 		{
 			name:          "near lat long",
 			in:            []AvailableOption{NearLatLong("34.0928", "118.3287")},
 			expectedPath:  "/sid/AvailablePhoneNumbers/US/Local.json",
-			expectedQuery: "NearLatLong=34.0928,118.3287",
+			expectedQuery: "NearLatLong=34.0928%2C118.3287",
 		},
 		{
 			name:          "distance",
@@ -98,13 +98,49 @@ func TestAvailableNumbers(t *testing.T) {
 			name:          "multiple options",
 			in:            []AvailableOption{InLATA("lata"), Distance(25), InRegion("CALIFORNIA")},
 			expectedPath:  "/sid/AvailablePhoneNumbers/US/Local.json",
-			expectedQuery: "Distance=25&InRegion=CALIFORNIA&InLata=lata",
+			expectedQuery: "Distance=25&InLata=lata&InRegion=CALIFORNIA",
 		},
 		{
 			name:          "multiple options",
 			in:            []AvailableOption{InLATA("lata"), Distance(25), InRegion("CALIFORNIA")},
 			expectedPath:  "/sid/AvailablePhoneNumbers/US/Local.json",
-			expectedQuery: "Distance=25&InRegion=CALIFORNIA&InLata=lata",
+			expectedQuery: "Distance=25&InLata=lata&InRegion=CALIFORNIA",
+		},
+		{
+			name: "capability and address filters",
+			in: []AvailableOption{
+				Contains("STORM"),
+				InAreaCode("306"),
+				SMSEnabled(true),
+				MMSEnabled(false),
+				VoiceEnabled(true),
+				FaxEnabled(false),
+				ExcludeAllAddressRequired(true),
+				ExcludeLocalAddressRequired(true),
+				ExcludeForeignAddressRequired(false),
+				IncludeBeta(false),
+				AvailablePageSize(20),
+			},
+			expectedPath: "/sid/AvailablePhoneNumbers/US/Local.json",
+			expectedQuery: "AreaCode=306&Beta=false&Contains=STORM&ExcludeAllAddressRequired=true&ExcludeForeignAddressRequired=false" +
+				"&ExcludeLocalAddressRequired=true&FaxEnabled=false&MmsEnabled=false&PageSize=20&SmsEnabled=true&VoiceEnabled=true",
+		},
+		{
+			name:          "escapes free text",
+			in:            []AvailableOption{Contains("+1306*"), InLocality("Rock & Roll")},
+			expectedPath:  "/sid/AvailablePhoneNumbers/US/Local.json",
+			expectedQuery: "Contains=%2B1306%2A&InLocality=Rock+%26+Roll",
+		},
+		{
+			name:         "toll free",
+			in:           []AvailableOption{AvailableNumberType(TollFreeNumbers)},
+			expectedPath: "/sid/AvailablePhoneNumbers/US/TollFree.json",
+		},
+		{
+			name:          "mobile",
+			in:            []AvailableOption{AvailableNumberType(MobileNumbers), InRegion("CALIFORNIA")},
+			expectedPath:  "/sid/AvailablePhoneNumbers/US/Mobile.json",
+			expectedQuery: "InRegion=CALIFORNIA",
 		},
 		{
 			name:          "check response",
@@ -215,7 +251,7 @@ func TestBuildValues(t *testing.T) {
 				InRateCenter: "CALIFORNIA",
 				InLata:       "",
 			},
-			expected: "NearNumber=12345678910&NearLatLong=34.0928118.3287&Distance=25&InPostalCode=90210&InLocality=HOLLYWOOD&InRegion=CALIFORNIA&InRateCenter=CALIFORNIA",
+			expected: "Distance=25&InLocality=HOLLYWOOD&InPostalCode=90210&InRateCenter=CALIFORNIA&InRegion=CALIFORNIA&NearLatLong=34.0928118.3287&NearNumber=12345678910",
 		},
 		{
 			name: "removes empty fields",
@@ -229,7 +265,7 @@ func TestBuildValues(t *testing.T) {
 				InRateCenter: "CALIFORNIA",
 				InLata:       "",
 			},
-			expected: "NearLatLong=34.0928118.3287&Distance=25&InPostalCode=90210&InLocality=HOLLYWOOD&InRegion=CALIFORNIA&InRateCenter=CALIFORNIA",
+			expected: "Distance=25&InLocality=HOLLYWOOD&InPostalCode=90210&InRateCenter=CALIFORNIA&InRegion=CALIFORNIA&NearLatLong=34.0928118.3287",
 		},
 	}
 
@@ -240,3 +276,22 @@ func TestBuildValues(t *testing.T) {
 		})
 	}
 }
+
+func TestAvailableCountries(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sid/AvailablePhoneNumbers.json", r.URL.Path)
+		fmt.Fprint(w, `{"countries": [{
+			"country_code": "GB",
+			"country": "United Kingdom",
+			"subresource_uris": {"mobile": "/GB/Mobile.json", "local": "/GB/Local.json", "national": "/GB/National.json"}
+		}]}`)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	actual, err := v.AvailableCountries()
+	assert.NoError(t, err)
+	assert.Len(t, actual.Countries, 1)
+	assert.Equal(t, "GB", actual.Countries[0].CountryCode)
+	assert.Equal(t, []NumberType{LocalNumbers, MobileNumbers, NationalNumbers}, actual.Countries[0].NumberTypes)
+}
@@ -0,0 +1,107 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// CurrentRecording can be used in place of a recording sid to control the call's active recording
+const CurrentRecording = "Twilio.CURRENT"
+
+// RecordingStatus is the state of a recording
+type RecordingStatus string
+
+const (
+	// RecordingInProgress is being recorded
+	RecordingInProgress RecordingStatus = "in-progress"
+	// RecordingPaused is paused and will resume when told to
+	RecordingPaused RecordingStatus = "paused"
+	// RecordingStopped is stopped and being processed
+	RecordingStopped RecordingStatus = "stopped"
+	// RecordingProcessing is being processed
+	RecordingProcessing RecordingStatus = "processing"
+	// RecordingCompleted is available to download
+	RecordingCompleted RecordingStatus = "completed"
+	// RecordingAbsent had no audio
+	RecordingAbsent RecordingStatus = "absent"
+)
+
+// StartCallRecording starts recording a call that is in progress
+func (v *VTwilio) StartCallRecording(callSID string, opts ...CallRecordingOption) (*Recording, error) {
+	if callSID == "" {
+		return nil, fmt.Errorf("must contain a call SID")
+	}
+	config := &callRecordingConfiguration{}
+	for _, o := range opts {
+		o(config)
+	}
+
+	values := url.Values{}
+	if config.Channels != "" {
+		values.Set("RecordingChannels", string(config.Channels))
+	}
+	if config.StatusCallback != "" {
+		values.Set("RecordingStatusCallback", config.StatusCallback)
+		if config.StatusCallbackMethod != "" {
+			values.Set("RecordingStatusCallbackMethod", config.StatusCallbackMethod.String())
+		}
+		events := make([]string, 0, len(config.StatusCallbackEvents))
+		for _, e := range config.StatusCallbackEvents {
+			events = append(events, string(e))
+		}
+		if len(events) > 0 {
+			values.Set("RecordingStatusCallbackEvent", strings.Join(events, " "))
+		}
+	}
+	if config.Trim != "" {
+		values.Set("Trim", string(config.Trim))
+	}
+
+	urlStr := fmt.Sprintf("%s%s%s/%s%s.json", v.baseAPI, v.accountSID, callsAPI, callSID, recordingsAPI)
+	return v.postRecording(urlStr, values)
+}
+
+// PauseCallRecording pauses a call's recording, use CurrentRecording for the active recording
+func (v *VTwilio) PauseCallRecording(callSID, recordingSID string, opts ...PauseOption) (*Recording, error) {
+	config := &pauseConfiguration{}
+	for _, o := range opts {
+		o(config)
+	}
+	values := url.Values{"Status": {string(RecordingPaused)}}
+	if config.PauseBehavior != "" {
+		values.Set("PauseBehavior", string(config.PauseBehavior))
+	}
+	return v.updateCallRecording(callSID, recordingSID, values)
+}
+
+// ResumeCallRecording resumes a paused recording
+func (v *VTwilio) ResumeCallRecording(callSID, recordingSID string) (*Recording, error) {
+	return v.updateCallRecording(callSID, recordingSID, url.Values{"Status": {string(RecordingInProgress)}})
+}
+
+// StopCallRecording stops a call's recording, a stopped recording can not be resumed
+func (v *VTwilio) StopCallRecording(callSID, recordingSID string) (*Recording, error) {
+	return v.updateCallRecording(callSID, recordingSID, url.Values{"Status": {string(RecordingStopped)}})
+}
+
+func (v *VTwilio) updateCallRecording(callSID, recordingSID string, values url.Values) (*Recording, error) {
+	if callSID == "" {
+		return nil, fmt.Errorf("must contain a call SID")
+	}
+	if recordingSID == "" {
+		return nil, fmt.Errorf("must contain a recording SID")
+	}
+	urlStr := fmt.Sprintf("%s%s%s/%s%s/%s.json", v.baseAPI, v.accountSID, callsAPI, callSID, recordingsAPI, recordingSID)
+	return v.postRecording(urlStr, values)
+}
+
+func (v *VTwilio) postRecording(urlStr string, values url.Values) (*Recording, error) {
+	req, err := http.NewRequest("POST", urlStr, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleRecording(req)
+}
@@ -0,0 +1,92 @@
+package vtwilio
+
+// RecordingChannel is how many channels a call recording has
+type RecordingChannel string
+
+const (
+	// MonoRecording mixes both legs of the call into one channel
+	MonoRecording RecordingChannel = "mono"
+	// DualRecording records each leg of the call on its own channel
+	DualRecording RecordingChannel = "dual"
+)
+
+// RecordingTrim is whether silence is trimmed from a recording
+type RecordingTrim string
+
+const (
+	// TrimSilence removes silence from the start and end of the recording
+	TrimSilence RecordingTrim = "trim-silence"
+	// DoNotTrim keeps the recording as is
+	DoNotTrim RecordingTrim = "do-not-trim"
+)
+
+// RecordingEvent is a recording event Twilio can send to a recording status callback
+type RecordingEvent string
+
+const (
+	// RecordingEventInProgress recording started or resumed
+	RecordingEventInProgress RecordingEvent = "in-progress"
+	// RecordingEventCompleted recording finished and is available
+	RecordingEventCompleted RecordingEvent = "completed"
+	// RecordingEventAbsent recording had no audio
+	RecordingEventAbsent RecordingEvent = "absent"
+)
+
+// PauseBehavior is what a paused section of a recording contains
+type PauseBehavior string
+
+const (
+	// PauseSkip leaves the paused section out of the recording
+	PauseSkip PauseBehavior = "skip"
+	// PauseSilence replaces the paused section with silence
+	PauseSilence PauseBehavior = "silence"
+)
+
+type callRecordingConfiguration struct {
+	Channels             RecordingChannel
+	StatusCallback       string
+	StatusCallbackMethod Method
+	StatusCallbackEvents []RecordingEvent
+	Trim                 RecordingTrim
+}
+
+// CallRecordingOption is an option for controlling the recording of a live call
+type CallRecordingOption func(*callRecordingConfiguration)
+
+// RecordingChannels sets whether a recording is mono or dual channel
+func RecordingChannels(c RecordingChannel) CallRecordingOption {
+	return func(r *callRecordingConfiguration) {
+		r.Channels = c
+	}
+}
+
+// RecordingStatusCallback is the url Twilio calls when the recording reaches one of the events.
+// Without events Twilio only calls it when the recording is completed.
+func RecordingStatusCallback(url string, method Method, events ...RecordingEvent) CallRecordingOption {
+	return func(r *callRecordingConfiguration) {
+		r.StatusCallback = url
+		r.StatusCallbackMethod = method
+		r.StatusCallbackEvents = events
+	}
+}
+
+// RecordingTrimSilence sets whether silence is trimmed from the recording
+func RecordingTrimSilence(t RecordingTrim) CallRecordingOption {
+	return func(r *callRecordingConfiguration) {
+		r.Trim = t
+	}
+}
+
+type pauseConfiguration struct {
+	PauseBehavior PauseBehavior
+}
+
+// PauseOption is an option for pausing the recording of a live call
+type PauseOption func(*pauseConfiguration)
+
+// RecordingPauseBehavior sets what a paused section of the recording contains, Twilio defaults to PauseSilence
+func RecordingPauseBehavior(b PauseBehavior) PauseOption {
+	return func(p *pauseConfiguration) {
+		p.PauseBehavior = b
+	}
+}
@@ -0,0 +1,107 @@
+package vtwilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallRecordingControls(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(v *VTwilio) (*Recording, error)
+		expectedPath   string
+		expectedForm   url.Values
+		expectedStatus RecordingStatus
+		expectedError  error
+	}{
+		{
+			name:           "start",
+			call:           func(v *VTwilio) (*Recording, error) { return v.StartCallRecording("CA123") },
+			expectedPath:   "/sid/Calls/CA123/Recordings.json",
+			expectedForm:   url.Values{},
+			expectedStatus: RecordingInProgress,
+		},
+		{
+			name: "start with options",
+			call: func(v *VTwilio) (*Recording, error) {
+				return v.StartCallRecording("CA123",
+					RecordingChannels(DualRecording),
+					RecordingStatusCallback("http://url.com/recording", POST, RecordingEventInProgress, RecordingEventCompleted),
+					RecordingTrimSilence(TrimSilence))
+			},
+			expectedPath: "/sid/Calls/CA123/Recordings.json",
+			expectedForm: url.Values{
+				"RecordingChannels":             {"dual"},
+				"RecordingStatusCallback":       {"http://url.com/recording"},
+				"RecordingStatusCallbackMethod": {"POST"},
+				"RecordingStatusCallbackEvent":  {"in-progress completed"},
+				"Trim":                          {"trim-silence"},
+			},
+			expectedStatus: RecordingInProgress,
+		},
+		{
+			name: "pause",
+			call: func(v *VTwilio) (*Recording, error) {
+				return v.PauseCallRecording("CA123", CurrentRecording, RecordingPauseBehavior(PauseSkip))
+			},
+			expectedPath:   "/sid/Calls/CA123/Recordings/Twilio.CURRENT.json",
+			expectedForm:   url.Values{"Status": {"paused"}, "PauseBehavior": {"skip"}},
+			expectedStatus: RecordingPaused,
+		},
+		{
+			name:           "resume",
+			call:           func(v *VTwilio) (*Recording, error) { return v.ResumeCallRecording("CA123", "RE123") },
+			expectedPath:   "/sid/Calls/CA123/Recordings/RE123.json",
+			expectedForm:   url.Values{"Status": {"in-progress"}},
+			expectedStatus: RecordingInProgress,
+		},
+		{
+			name:           "stop",
+			call:           func(v *VTwilio) (*Recording, error) { return v.StopCallRecording("CA123", "RE123") },
+			expectedPath:   "/sid/Calls/CA123/Recordings/RE123.json",
+			expectedForm:   url.Values{"Status": {"stopped"}},
+			expectedStatus: RecordingStopped,
+		},
+		{
+			name:          "no call sid",
+			call:          func(v *VTwilio) (*Recording, error) { return v.StartCallRecording("") },
+			expectedError: fmt.Errorf("must contain a call SID"),
+		},
+		{
+			name:          "no recording sid",
+			call:          func(v *VTwilio) (*Recording, error) { return v.StopCallRecording("CA123", "") },
+			expectedError: fmt.Errorf("must contain a recording SID"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read body: %v", err)
+				}
+				form, err := url.ParseQuery(string(body))
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedForm, form)
+				fmt.Fprintf(w, `{"sid": "RE123", "call_sid": "CA123", "status": %q}`, tt.expectedStatus)
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+			actual, err := tt.call(v)
+			assert.Equal(t, tt.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tt.expectedStatus, actual.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package vtwilio
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Call is a call resource from Twilio
+type Call struct {
+	SID             string            `json:"sid"`
+	ParentCallSID   string            `json:"parent_call_sid"`
+	AccountSID      string            `json:"account_sid"`
+	DateCreated     string            `json:"date_created"`
+	DateUpdated     string            `json:"date_updated"`
+	To              string            `json:"to"`
+	ToFormatted     string            `json:"to_formatted"`
+	From            string            `json:"from"`
+	FromFormatted   string            `json:"from_formatted"`
+	PhoneNumberSID  string            `json:"phone_number_sid"`
+	Status          string            `json:"status"`
+	StartTime       time.Time         `json:"-"`
+	EndTime         time.Time         `json:"-"`
+	Duration        time.Duration     `json:"-"`
+	QueueTime       time.Duration     `json:"-"`
+	Price           float64           `json:"-"`
+	PriceUnit       string            `json:"price_unit"`
+	Direction       string            `json:"direction"`
+	AnsweredBy      string            `json:"answered_by"`
+	CallerName      string            `json:"caller_name"`
+	ForwardedFrom   string            `json:"forwarded_from"`
+	APIVersion      string            `json:"api_version"`
+	URI             string            `json:"uri"`
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+}
+
+// callTimes are the call fields Twilio sends as strings that are parsed into typed fields
+type callTimes struct {
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Duration  string `json:"duration"`
+	QueueTime string `json:"queue_time"`
+	Price     string `json:"price"`
+}
+
+// UnmarshalJSON parses the call's times, duration and price
+func (c *Call) UnmarshalJSON(b []byte) error {
+	type call Call
+	aux := struct {
+		*call
+		callTimes
+	}{call: (*call)(c)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if aux.callTimes.StartTime != "" {
+		if c.StartTime, err = ToTime(aux.callTimes.StartTime); err != nil {
+			return err
+		}
+	}
+	if aux.callTimes.EndTime != "" {
+		if c.EndTime, err = ToTime(aux.callTimes.EndTime); err != nil {
+			return err
+		}
+	}
+	if aux.callTimes.Duration != "" {
+		seconds, err := strconv.Atoi(aux.callTimes.Duration)
+		if err != nil {
+			return fmt.Errorf("invalid call duration %q", aux.callTimes.Duration)
+		}
+		c.Duration = time.Duration(seconds) * time.Second
+	}
+	if aux.callTimes.QueueTime != "" {
+		ms, err := strconv.Atoi(aux.callTimes.QueueTime)
+		if err != nil {
+			return fmt.Errorf("invalid call queue time %q", aux.callTimes.QueueTime)
+		}
+		c.QueueTime = time.Duration(ms) * time.Millisecond
+	}
+	if aux.callTimes.Price != "" {
+		if c.Price, err = strconv.ParseFloat(aux.callTimes.Price, 64); err != nil {
+			return fmt.Errorf("invalid call price %q", aux.callTimes.Price)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the call in the format Twilio uses
+func (c Call) MarshalJSON() ([]byte, error) {
+	type call Call
+	aux := struct {
+		call
+		callTimes
+	}{call: call(c)}
+	if !c.StartTime.IsZero() {
+		aux.callTimes.StartTime = c.StartTime.Format(twilioTimeFormat)
+	}
+	if !c.EndTime.IsZero() {
+		aux.callTimes.EndTime = c.EndTime.Format(twilioTimeFormat)
+	}
+	if c.Duration != 0 {
+		aux.callTimes.Duration = strconv.Itoa(int(c.Duration / time.Second))
+	}
+	if c.QueueTime != 0 {
+		aux.callTimes.QueueTime = strconv.Itoa(int(c.QueueTime / time.Millisecond))
+	}
+	if c.Price != 0 {
+		aux.callTimes.Price = strconv.FormatFloat(c.Price, 'f', -1, 64)
+	}
+	return json.Marshal(aux)
+}
+
+// CallList is a page of calls
+type CallList struct {
+	FirstPageURI    string  `json:"first_page_uri"`
+	NextPageURI     string  `json:"next_page_uri"`
+	PreviousPageURI string  `json:"previous_page_uri"`
+	Page            int     `json:"page"`
+	PageSize        int     `json:"page_size"`
+	End             int     `json:"end"`
+	Calls           []*Call `json:"calls"`
+}
+
+// MakeCall starts an outbound call. When from is empty the client's number is used.
+// Either CallURL or CallTwiML must be set.
+func (v *VTwilio) MakeCall(to, from string, opts ...CallOption) (*Call, error) {
+	to, err := v.normalizeNumber(to)
+	if err != nil {
+		return nil, err
+	}
+	if from == "" {
+		from = v.twilioNumber
+	}
+	from, err = v.normalizeNumber(from)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &callConfiguration{}
+	for _, o := range opts {
+		o(config)
+	}
+	if config.URL == "" && config.TwiML == nil {
+		return nil, fmt.Errorf("a call must have a url or twiml")
+	}
+
+	values := url.Values{}
+	values.Set("To", to)
+	values.Set("From", from)
+	if err := setCallInstructions(values, config); err != nil {
+		return nil, err
+	}
+	if config.StatusCallback != "" {
+		values.Set("StatusCallback", config.StatusCallback)
+		if config.StatusCallbackMethod != "" {
+			values.Set("StatusCallbackMethod", config.StatusCallbackMethod.String())
+		}
+		for _, e := range config.StatusCallbackEvents {
+			values.Add("StatusCallbackEvent", string(e))
+		}
+	}
+	if t := config.timeout(); t != "" {
+		values.Set("Timeout", t)
+	}
+	if config.Record {
+		values.Set("Record", "true")
+	}
+	if config.MachineDetection != "" {
+		values.Set("MachineDetection", string(config.MachineDetection))
+	}
+	if config.SendDigits != "" {
+		values.Set("SendDigits", config.SendDigits)
+	}
+
+	urlStr := fmt.Sprintf("%s%s%s.json", v.baseAPI, v.accountSID, callsAPI)
+	req, err := http.NewRequest("POST", urlStr, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleCall(req)
+}
+
+// setCallInstructions sets the Url or inline Twiml parameter of a call
+func setCallInstructions(values url.Values, config *callConfiguration) error {
+	if config.TwiML != nil {
+		b, err := config.TwiML.Build()
+		if err != nil {
+			return err
+		}
+		values.Set("Twiml", string(b))
+		return nil
+	}
+	values.Set("Url", config.URL)
+	if config.Method != "" {
+		values.Set("Method", config.Method.String())
+	}
+	return nil
+}
+
+// GetCall gets a call by its sid
+func (v *VTwilio) GetCall(sid string) (*Call, error) {
+	if sid == "" {
+		return nil, fmt.Errorf("must contain a call SID")
+	}
+	req, err := http.NewRequest("GET", v.callURL(sid), nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleCall(req)
+}
+
+// ListCalls returns a page of calls
+func (v *VTwilio) ListCalls(opts ...CallListOption) (*CallList, error) {
+	c := &callListConfiguration{
+		PageSize: 10,
+		Page:     0,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	values := url.Values{}
+	values.Set("PageSize", strconv.Itoa(c.PageSize))
+	values.Set("Page", strconv.Itoa(c.Page))
+	if c.Status != "" {
+		values.Set("Status", c.Status)
+	}
+	for key, n := range map[string]string{"To": c.To, "From": c.From} {
+		if n == "" {
+			continue
+		}
+		normalized, err := v.normalizeNumber(n)
+		if err != nil {
+			return nil, err
+		}
+		values.Set(key, normalized)
+	}
+	if !c.StartedAfter.IsZero() {
+		values.Set("StartTime>", c.StartedAfter.UTC().Format("2006-01-02"))
+	}
+	if !c.StartedBefore.IsZero() {
+		values.Set("StartTime<", c.StartedBefore.UTC().Format("2006-01-02"))
+	}
+
+	urlStr := fmt.Sprintf("%s%s%s.json?%s", v.baseAPI, v.accountSID, callsAPI, values.Encode())
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleListCalls(req)
+}
+
+// RedirectCall sends a live call new instructions from a CallURL or CallTwiML.
+// CallStatusCallback may also be changed, the other call options are ignored.
+func (v *VTwilio) RedirectCall(sid string, opts ...CallOption) (*Call, error) {
+	config := &callConfiguration{}
+	for _, o := range opts {
+		o(config)
+	}
+	if config.URL == "" && config.TwiML == nil {
+		return nil, fmt.Errorf("a call must have a url or twiml")
+	}
+
+	values := url.Values{}
+	if err := setCallInstructions(values, config); err != nil {
+		return nil, err
+	}
+	if config.StatusCallback != "" {
+		values.Set("StatusCallback", config.StatusCallback)
+		if config.StatusCallbackMethod != "" {
+			values.Set("StatusCallbackMethod", config.StatusCallbackMethod.String())
+		}
+	}
+	return v.updateCall(sid, values)
+}
+
+// EndCall hangs up a call that is in progress
+func (v *VTwilio) EndCall(sid string) (*Call, error) {
+	return v.updateCall(sid, url.Values{"Status": {"completed"}})
+}
+
+// CancelCall cancels a call that is queued or ringing, it has no effect on a call in progress
+func (v *VTwilio) CancelCall(sid string) (*Call, error) {
+	return v.updateCall(sid, url.Values{"Status": {"canceled"}})
+}
+
+func (v *VTwilio) updateCall(sid string, values url.Values) (*Call, error) {
+	if sid == "" {
+		return nil, fmt.Errorf("must contain a call SID")
+	}
+	req, err := http.NewRequest("POST", v.callURL(sid), strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleCall(req)
+}
+
+func (v *VTwilio) callURL(sid string) string {
+	return fmt.Sprintf("%s%s%s/%s.json", v.baseAPI, v.accountSID, callsAPI, sid)
+}
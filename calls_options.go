@@ -0,0 +1,189 @@
+package vtwilio
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/twiebe-va/vtwilio-go/twiml"
+)
+
+// CallEvent is a call progress event Twilio can send to a status callback
+type CallEvent string
+
+const (
+	// CallInitiated event
+	CallInitiated CallEvent = "initiated"
+	// CallRinging event
+	CallRinging CallEvent = "ringing"
+	// CallAnswered event
+	CallAnswered CallEvent = "answered"
+	// CallCompleted event
+	CallCompleted CallEvent = "completed"
+)
+
+// MachineDetection is the answering machine detection mode
+type MachineDetection string
+
+const (
+	// DetectMachine returns as soon as a human or machine is detected
+	DetectMachine MachineDetection = "Enable"
+	// DetectMessageEnd waits for the end of a machine greeting so a message can be left
+	DetectMessageEnd MachineDetection = "DetectMessageEnd"
+)
+
+type callConfiguration struct {
+	URL                  string
+	Method               Method
+	TwiML                *twiml.TwiML
+	StatusCallback       string
+	StatusCallbackMethod Method
+	StatusCallbackEvents []CallEvent
+	Timeout              int
+	Record               bool
+	MachineDetection     MachineDetection
+	SendDigits           string
+}
+
+// CallOption is an option for a call being made
+type CallOption func(*callConfiguration)
+
+// CallURL is the url Twilio requests for TwiML instructions when the call is answered
+func CallURL(url string, method Method) CallOption {
+	return func(c *callConfiguration) {
+		c.URL = url
+		c.Method = method
+	}
+}
+
+// CallTwiML are the instructions for the call, sent inline instead of a CallURL
+func CallTwiML(t *twiml.TwiML) CallOption {
+	return func(c *callConfiguration) {
+		c.TwiML = t
+	}
+}
+
+// CallStatusCallback is the url Twilio calls when the call reaches one of the events.
+// Without events Twilio only calls it when the call is completed.
+func CallStatusCallback(url string, method Method, events ...CallEvent) CallOption {
+	return func(c *callConfiguration) {
+		c.StatusCallback = url
+		c.StatusCallbackMethod = method
+		c.StatusCallbackEvents = events
+	}
+}
+
+// CallTimeout is how many seconds to let the call ring before giving up, Twilio defaults to 60
+func CallTimeout(seconds int) CallOption {
+	return func(c *callConfiguration) {
+		c.Timeout = seconds
+	}
+}
+
+// CallRecord records the call
+func CallRecord() CallOption {
+	return func(c *callConfiguration) {
+		c.Record = true
+	}
+}
+
+// CallMachineDetection detects if a human or an answering machine picked up the call
+func CallMachineDetection(m MachineDetection) CallOption {
+	return func(c *callConfiguration) {
+		c.MachineDetection = m
+	}
+}
+
+// CallSendDigits are keys to dial after the call is connected e.g. an extension "ww1234#"
+func CallSendDigits(d string) CallOption {
+	return func(c *callConfiguration) {
+		c.SendDigits = d
+	}
+}
+
+func (c *callConfiguration) timeout() string {
+	if c.Timeout <= 0 {
+		return ""
+	}
+	return strconv.Itoa(c.Timeout)
+}
+
+const (
+	// CallStatusQueued call is waiting to be dialed
+	CallStatusQueued = "queued"
+	// CallStatusRinging call is ringing
+	CallStatusRinging = "ringing"
+	// CallStatusInProgress call was answered
+	CallStatusInProgress = "in-progress"
+	// CallStatusCanceled call was canceled before it was answered
+	CallStatusCanceled = "canceled"
+	// CallStatusCompleted call was answered and has ended
+	CallStatusCompleted = "completed"
+	// CallStatusFailed call could not be completed
+	CallStatusFailed = "failed"
+	// CallStatusBusy callee was busy
+	CallStatusBusy = "busy"
+	// CallStatusNoAnswer callee did not answer
+	CallStatusNoAnswer = "no-answer"
+)
+
+type callListConfiguration struct {
+	Status        string
+	To            string
+	From          string
+	StartedAfter  time.Time
+	StartedBefore time.Time
+	PageSize      int
+	Page          int
+}
+
+// CallListOption is an option for listing calls
+type CallListOption func(*callListConfiguration)
+
+// CallsWithStatus only lists calls with the status e.g. CallStatusInProgress
+func CallsWithStatus(status string) CallListOption {
+	return func(c *callListConfiguration) {
+		c.Status = status
+	}
+}
+
+// CallsTo only lists calls made to the number
+func CallsTo(number string) CallListOption {
+	return func(c *callListConfiguration) {
+		c.To = number
+	}
+}
+
+// CallsFrom only lists calls made from the number
+func CallsFrom(number string) CallListOption {
+	return func(c *callListConfiguration) {
+		c.From = number
+	}
+}
+
+// CallsStartedOnOrAfter only lists calls that started on or after the day
+func CallsStartedOnOrAfter(date time.Time) CallListOption {
+	return func(c *callListConfiguration) {
+		c.StartedAfter = date
+	}
+}
+
+// CallsStartedOnOrBefore only lists calls that started on or before the day
+func CallsStartedOnOrBefore(date time.Time) CallListOption {
+	return func(c *callListConfiguration) {
+		c.StartedBefore = date
+	}
+}
+
+// CallsPageSize sets the page size, defaults to 10
+func CallsPageSize(pageSize int) CallListOption {
+	return func(c *callListConfiguration) {
+		c.PageSize = pageSize
+	}
+}
+
+// CallsPage sets the page number, defaults to 0
+func CallsPage(page int) CallListOption {
+	return func(c *callListConfiguration) {
+		c.Page = page
+	}
+}
@@ -0,0 +1,332 @@
+package vtwilio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twiebe-va/vtwilio-go/twiml"
+)
+
+func TestMakeCall(t *testing.T) {
+	expected := &Call{
+		SID:       "CA123",
+		To:        "+14155551234",
+		From:      "+12345678910",
+		Status:    "queued",
+		Direction: "outbound-api",
+	}
+
+	tests := []struct {
+		name          string
+		to, from      string
+		opts          []CallOption
+		expectedForm  url.Values
+		expected      *Call
+		expectedError error
+	}{
+		{
+			name:     "url",
+			to:       "+14155551234",
+			opts:     []CallOption{CallURL("http://url.com/voice", POST)},
+			expected: expected,
+			expectedForm: url.Values{
+				"To":     {"+14155551234"},
+				"From":   {"+12345678910"},
+				"Url":    {"http://url.com/voice"},
+				"Method": {"POST"},
+			},
+		},
+		{
+			name:     "override from",
+			to:       "+14155551234",
+			from:     "+10987654321",
+			opts:     []CallOption{CallURL("http://url.com/voice", "")},
+			expected: expected,
+			expectedForm: url.Values{
+				"To":   {"+14155551234"},
+				"From": {"+10987654321"},
+				"Url":  {"http://url.com/voice"},
+			},
+		},
+		{
+			name:     "inline twiml",
+			to:       "+14155551234",
+			opts:     []CallOption{CallTwiML(twiml.NewTwiML().Say("Hello"))},
+			expected: expected,
+			expectedForm: url.Values{
+				"To":    {"+14155551234"},
+				"From":  {"+12345678910"},
+				"Twiml": {"<Response>\n\t<Say>Hello</Say>\n</Response>"},
+			},
+		},
+		{
+			name: "all options",
+			to:   "+14155551234",
+			opts: []CallOption{
+				CallURL("http://url.com/voice", GET),
+				CallStatusCallback("http://url.com/status", POST, CallRinging, CallCompleted),
+				CallTimeout(20),
+				CallRecord(),
+				CallMachineDetection(DetectMessageEnd),
+				CallSendDigits("ww1234#"),
+			},
+			expected: expected,
+			expectedForm: url.Values{
+				"To":                   {"+14155551234"},
+				"From":                 {"+12345678910"},
+				"Url":                  {"http://url.com/voice"},
+				"Method":               {"GET"},
+				"StatusCallback":       {"http://url.com/status"},
+				"StatusCallbackMethod": {"POST"},
+				"StatusCallbackEvent":  {"ringing", "completed"},
+				"Timeout":              {"20"},
+				"Record":               {"true"},
+				"MachineDetection":     {"DetectMessageEnd"},
+				"SendDigits":           {"ww1234#"},
+			},
+		},
+		{
+			name:          "no instructions",
+			to:            "+14155551234",
+			expectedError: fmt.Errorf("a call must have a url or twiml"),
+		},
+		{
+			name:          "invalid to",
+			to:            "4155551234",
+			opts:          []CallOption{CallURL("http://url.com/voice", POST)},
+			expectedError: fmt.Errorf("phone number must begin with + or a default region must be set"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/sid/Calls.json", r.URL.Path)
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read body: %v", err)
+				}
+				form, err := url.ParseQuery(string(body))
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedForm, form)
+
+				bytes, err := json.Marshal(expected)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(bytes)
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{
+				accountSID:   "sid",
+				authToken:    "token",
+				twilioNumber: "+12345678910",
+				baseAPI:      fmt.Sprintf("%s/", ts.URL),
+			}
+			actual, err := v.MakeCall(tt.to, tt.from, tt.opts...)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+const callResponse = `{
+	"sid": "CA123",
+	"to": "+14155551234",
+	"from": "+12345678910",
+	"status": "completed",
+	"start_time": "Thu, 31 Aug 2017 01:10:56 +0000",
+	"end_time": "Thu, 31 Aug 2017 01:12:11 +0000",
+	"duration": "75",
+	"queue_time": "1500",
+	"price": "-0.0130",
+	"price_unit": "USD"
+}`
+
+func TestCallJSON(t *testing.T) {
+	expected := &Call{
+		SID:       "CA123",
+		To:        "+14155551234",
+		From:      "+12345678910",
+		Status:    CallStatusCompleted,
+		StartTime: time.Date(2017, time.August, 31, 1, 10, 56, 0, time.UTC),
+		EndTime:   time.Date(2017, time.August, 31, 1, 12, 11, 0, time.UTC),
+		Duration:  75 * time.Second,
+		QueueTime: 1500 * time.Millisecond,
+		Price:     -0.013,
+		PriceUnit: "USD",
+	}
+
+	var actual Call
+	assert.NoError(t, json.Unmarshal([]byte(callResponse), &actual))
+	assert.Equal(t, expected, &actual)
+
+	b, err := json.Marshal(expected)
+	assert.NoError(t, err)
+	var roundTrip Call
+	assert.NoError(t, json.Unmarshal(b, &roundTrip))
+	assert.Equal(t, expected, &roundTrip)
+
+	t.Run("unanswered call", func(t *testing.T) {
+		var c Call
+		assert.NoError(t, json.Unmarshal([]byte(`{"sid": "CA123", "duration": null, "price": null, "start_time": null}`), &c))
+		assert.Equal(t, Call{SID: "CA123"}, c)
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		var c Call
+		assert.Equal(t, fmt.Errorf("invalid call duration \"abc\""), json.Unmarshal([]byte(`{"duration": "abc"}`), &c))
+	})
+}
+
+func TestListCalls(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            []CallListOption
+		expectedQuery url.Values
+		expectedError error
+	}{
+		{
+			name:          "no options",
+			expectedQuery: url.Values{"PageSize": {"10"}, "Page": {"0"}},
+		},
+		{
+			name: "all options",
+			in: []CallListOption{
+				CallsWithStatus(CallStatusInProgress),
+				CallsTo("+14155551234"),
+				CallsFrom("+12345678910"),
+				CallsStartedOnOrAfter(time.Date(2017, time.August, 1, 0, 0, 0, 0, time.UTC)),
+				CallsStartedOnOrBefore(time.Date(2017, time.August, 31, 0, 0, 0, 0, time.UTC)),
+				CallsPageSize(50),
+				CallsPage(2),
+			},
+			expectedQuery: url.Values{
+				"PageSize":   {"50"},
+				"Page":       {"2"},
+				"Status":     {"in-progress"},
+				"To":         {"+14155551234"},
+				"From":       {"+12345678910"},
+				"StartTime>": {"2017-08-01"},
+				"StartTime<": {"2017-08-31"},
+			},
+		},
+		{
+			name:          "invalid number",
+			in:            []CallListOption{CallsTo("abc")},
+			expectedError: fmt.Errorf("phone number contains invalid character 'a'"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/sid/Calls.json", r.URL.Path)
+				assert.Equal(t, tt.expectedQuery, r.URL.Query())
+				w.Write([]byte(`{"page": 0, "page_size": 10, "calls": [` + callResponse + `]}`))
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+			actual, err := v.ListCalls(tt.in...)
+			assert.Equal(t, tt.expectedError, err)
+			if err == nil {
+				assert.Len(t, actual.Calls, 1)
+				assert.Equal(t, 75*time.Second, actual.Calls[0].Duration)
+			}
+		})
+	}
+}
+
+func TestUpdateCalls(t *testing.T) {
+	tests := []struct {
+		name          string
+		call          func(v *VTwilio) (*Call, error)
+		expectedPath  string
+		expectedForm  url.Values
+		expectedError error
+	}{
+		{
+			name:         "get",
+			call:         func(v *VTwilio) (*Call, error) { return v.GetCall("CA123") },
+			expectedPath: "/sid/Calls/CA123.json",
+		},
+		{
+			name: "redirect to url",
+			call: func(v *VTwilio) (*Call, error) {
+				return v.RedirectCall("CA123", CallURL("http://url.com/next", POST), CallTimeout(10))
+			},
+			expectedPath: "/sid/Calls/CA123.json",
+			expectedForm: url.Values{"Url": {"http://url.com/next"}, "Method": {"POST"}},
+		},
+		{
+			name: "redirect to twiml",
+			call: func(v *VTwilio) (*Call, error) {
+				return v.RedirectCall("CA123", CallTwiML(twiml.NewTwiML().Say("Goodbye")))
+			},
+			expectedPath: "/sid/Calls/CA123.json",
+			expectedForm: url.Values{"Twiml": {"<Response>\n\t<Say>Goodbye</Say>\n</Response>"}},
+		},
+		{
+			name:          "redirect without instructions",
+			call:          func(v *VTwilio) (*Call, error) { return v.RedirectCall("CA123") },
+			expectedError: fmt.Errorf("a call must have a url or twiml"),
+		},
+		{
+			name:         "end",
+			call:         func(v *VTwilio) (*Call, error) { return v.EndCall("CA123") },
+			expectedPath: "/sid/Calls/CA123.json",
+			expectedForm: url.Values{"Status": {"completed"}},
+		},
+		{
+			name:         "cancel",
+			call:         func(v *VTwilio) (*Call, error) { return v.CancelCall("CA123") },
+			expectedPath: "/sid/Calls/CA123.json",
+			expectedForm: url.Values{"Status": {"canceled"}},
+		},
+		{
+			name:          "no sid",
+			call:          func(v *VTwilio) (*Call, error) { return v.EndCall("") },
+			expectedError: fmt.Errorf("must contain a call SID"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				if tt.expectedForm != nil {
+					assert.Equal(t, "POST", r.Method)
+					body, err := ioutil.ReadAll(r.Body)
+					if err != nil {
+						t.Errorf("failed to read body: %v", err)
+					}
+					form, err := url.ParseQuery(string(body))
+					assert.NoError(t, err)
+					assert.Equal(t, tt.expectedForm, form)
+				} else {
+					assert.Equal(t, "GET", r.Method)
+				}
+				w.Write([]byte(callResponse))
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+			actual, err := tt.call(v)
+			assert.Equal(t, tt.expectedError, err)
+			if err == nil {
+				assert.Equal(t, "CA123", actual.SID)
+			}
+		})
+	}
+}
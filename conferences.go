@@ -0,0 +1,107 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Conference is a conference resource from Twilio
+type Conference struct {
+	SID                     string            `json:"sid"`
+	AccountSID              string            `json:"account_sid"`
+	FriendlyName            string            `json:"friendly_name"`
+	Status                  string            `json:"status"`
+	Region                  string            `json:"region"`
+	DateCreated             string            `json:"date_created"`
+	DateUpdated             string            `json:"date_updated"`
+	ReasonConferenceEnded   string            `json:"reason_conference_ended"`
+	CallSIDEndingConference string            `json:"call_sid_ending_conference"`
+	APIVersion              string            `json:"api_version"`
+	URI                     string            `json:"uri"`
+	SubresourceURIs         map[string]string `json:"subresource_uris"`
+}
+
+// ConferenceList is a page of conferences
+type ConferenceList struct {
+	FirstPageURI    string        `json:"first_page_uri"`
+	NextPageURI     string        `json:"next_page_uri"`
+	PreviousPageURI string        `json:"previous_page_uri"`
+	Page            int           `json:"page"`
+	PageSize        int           `json:"page_size"`
+	End             int           `json:"end"`
+	Conferences     []*Conference `json:"conferences"`
+}
+
+// ListConferences returns a page of conferences
+func (v *VTwilio) ListConferences(opts ...ConferenceListOption) (*ConferenceList, error) {
+	c := &conferenceListConfiguration{
+		PageSize: 10,
+		Page:     0,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	values := url.Values{}
+	values.Set("PageSize", strconv.Itoa(c.PageSize))
+	values.Set("Page", strconv.Itoa(c.Page))
+	if c.FriendlyName != "" {
+		values.Set("FriendlyName", c.FriendlyName)
+	}
+	if c.Status != "" {
+		values.Set("Status", c.Status)
+	}
+	if !c.Date.IsZero() {
+		date := c.Date.UTC().Format("2006-01-02")
+		switch c.DateRange {
+		case before:
+			values.Set("DateCreated<", date)
+		case after:
+			values.Set("DateCreated>", date)
+		default:
+			values.Set("DateCreated", date)
+		}
+	}
+
+	urlStr := fmt.Sprintf("%s%s%s.json?%s", v.baseAPI, v.accountSID, conferencesAPI, values.Encode())
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleListConferences(req)
+}
+
+// GetConference gets a conference by its sid
+func (v *VTwilio) GetConference(sid string) (*Conference, error) {
+	if sid == "" {
+		return nil, fmt.Errorf("must contain a conference SID")
+	}
+	req, err := http.NewRequest("GET", v.conferenceURL(sid), nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleConference(req)
+}
+
+// EndConference ends a conference and disconnects all of its participants
+func (v *VTwilio) EndConference(sid string) (*Conference, error) {
+	if sid == "" {
+		return nil, fmt.Errorf("must contain a conference SID")
+	}
+	values := url.Values{"Status": {ConferenceCompleted}}
+	req, err := http.NewRequest("POST", v.conferenceURL(sid), strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleConference(req)
+}
+
+func (v *VTwilio) conferenceURL(sid string) string {
+	return fmt.Sprintf("%s%s%s/%s.json", v.baseAPI, v.accountSID, conferencesAPI, sid)
+}
@@ -0,0 +1,78 @@
+package vtwilio
+
+import (
+	"time"
+)
+
+const (
+	// ConferenceInit conference was created and is waiting for participants
+	ConferenceInit = "init"
+	// ConferenceInProgress conference has started
+	ConferenceInProgress = "in-progress"
+	// ConferenceCompleted conference has ended
+	ConferenceCompleted = "completed"
+)
+
+type conferenceListConfiguration struct {
+	FriendlyName string
+	Status       string
+	Date         time.Time
+	DateRange    dateOption
+	PageSize     int
+	Page         int
+}
+
+// ConferenceListOption is an option for listing conferences
+type ConferenceListOption func(*conferenceListConfiguration)
+
+// ConferencesNamed only lists conferences with the friendly name
+func ConferencesNamed(name string) ConferenceListOption {
+	return func(c *conferenceListConfiguration) {
+		c.FriendlyName = name
+	}
+}
+
+// ConferencesWithStatus only lists conferences with the status e.g. ConferenceInProgress
+func ConferencesWithStatus(status string) ConferenceListOption {
+	return func(c *conferenceListConfiguration) {
+		c.Status = status
+	}
+}
+
+// ConferencesCreatedOn only lists conferences created on the day
+func ConferencesCreatedOn(date time.Time) ConferenceListOption {
+	return func(c *conferenceListConfiguration) {
+		c.Date = date
+		c.DateRange = equal
+	}
+}
+
+// ConferencesCreatedOnOrBefore only lists conferences created on or before the day
+func ConferencesCreatedOnOrBefore(date time.Time) ConferenceListOption {
+	return func(c *conferenceListConfiguration) {
+		c.Date = date
+		c.DateRange = before
+	}
+}
+
+// ConferencesCreatedOnOrAfter only lists conferences created on or after the day
+func ConferencesCreatedOnOrAfter(date time.Time) ConferenceListOption {
+	return func(c *conferenceListConfiguration) {
+		c.Date = date
+		c.DateRange = after
+	}
+}
+
+// ConferencesPageSize sets the page size, defaults to 10
+func ConferencesPageSize(pageSize int) ConferenceListOption {
+	return func(c *conferenceListConfiguration) {
+		c.PageSize = pageSize
+	}
+}
+
+// ConferencesPage sets the page number, defaults to 0
+func ConferencesPage(page int) ConferenceListOption {
+	return func(c *conferenceListConfiguration) {
+		c.Page = page
+	}
+}
@@ -0,0 +1,99 @@
+package vtwilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListConferences(t *testing.T) {
+	date := time.Date(2018, time.March, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		opts          []ConferenceListOption
+		expectedQuery url.Values
+	}{
+		{
+			name:          "defaults",
+			expectedQuery: url.Values{"PageSize": {"10"}, "Page": {"0"}},
+		},
+		{
+			name: "filters",
+			opts: []ConferenceListOption{
+				ConferencesNamed("support"),
+				ConferencesWithStatus(ConferenceInProgress),
+				ConferencesCreatedOnOrAfter(date),
+				ConferencesPageSize(50),
+				ConferencesPage(2),
+			},
+			expectedQuery: url.Values{
+				"FriendlyName": {"support"},
+				"Status":       {"in-progress"},
+				"DateCreated>": {"2018-03-02"},
+				"PageSize":     {"50"},
+				"Page":         {"2"},
+			},
+		},
+		{
+			name:          "created on",
+			opts:          []ConferenceListOption{ConferencesCreatedOn(date)},
+			expectedQuery: url.Values{"DateCreated": {"2018-03-02"}, "PageSize": {"10"}, "Page": {"0"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/sid/Conferences.json", r.URL.Path)
+				assert.Equal(t, tt.expectedQuery, r.URL.Query())
+				fmt.Fprint(w, `{"page": 0, "page_size": 10, "next_page_uri": "/next", "conferences": [{"sid": "CF123", "friendly_name": "support", "status": "in-progress"}]}`)
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+			actual, err := v.ListConferences(tt.opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, &ConferenceList{
+				PageSize:    10,
+				NextPageURI: "/next",
+				Conferences: []*Conference{{SID: "CF123", FriendlyName: "support", Status: ConferenceInProgress}},
+			}, actual)
+		})
+	}
+}
+
+func TestGetAndEndConference(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sid/Conferences/CF123.json", r.URL.Path)
+		status := ConferenceInProgress
+		if r.Method == "POST" {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("failed to read body: %v", err)
+			}
+			assert.Equal(t, "Status=completed", string(body))
+			status = ConferenceCompleted
+		}
+		fmt.Fprintf(w, `{"sid": "CF123", "status": %q}`, status)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	c, err := v.GetConference("CF123")
+	assert.NoError(t, err)
+	assert.Equal(t, ConferenceInProgress, c.Status)
+
+	c, err = v.EndConference("CF123")
+	assert.NoError(t, err)
+	assert.Equal(t, ConferenceCompleted, c.Status)
+
+	_, err = v.EndConference("")
+	assert.Equal(t, fmt.Errorf("must contain a conference SID"), err)
+}
@@ -0,0 +1,384 @@
+package vtwilio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/twiebe-va/vtwilio-go/twiml"
+)
+
+// SessionKey identifies a conversation, the account's number and the number of the person it is talking to
+type SessionKey struct {
+	OurNumber   string
+	TheirNumber string
+}
+
+// Session is where a person is in a conversation
+type Session struct {
+	State string
+	// Data holds the values passed to StartConversation and the replies saved with SaveReply
+	Data      map[string]string
+	UpdatedAt time.Time
+}
+
+// SessionStore keeps conversation sessions
+type SessionStore interface {
+	Get(key SessionKey) (*Session, bool, error)
+	Set(key SessionKey, s *Session) error
+	Delete(key SessionKey) error
+}
+
+// MemorySessionStore is a SessionStore that keeps sessions in memory. A session is removed when its
+// conversation ends, sessions of conversations that never end stay until Prune removes them.
+type MemorySessionStore struct {
+	mu       sync.RWMutex
+	sessions map[SessionKey]Session
+}
+
+// NewMemorySessionStore returns an empty in memory session store
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{sessions: map[SessionKey]Session{}}
+}
+
+// Get returns a copy of the session for key
+func (s *MemorySessionStore) Get(key SessionKey) (*Session, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	session, ok := s.sessions[key]
+	if !ok {
+		return nil, false, nil
+	}
+	session.Data = copyData(session.Data)
+	return &session, true, nil
+}
+
+// Set saves the session for key
+func (s *MemorySessionStore) Set(key SessionKey, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved := *session
+	saved.Data = copyData(session.Data)
+	s.sessions[key] = saved
+	return nil
+}
+
+// Delete removes the session for key
+func (s *MemorySessionStore) Delete(key SessionKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, key)
+	return nil
+}
+
+// Prune removes the sessions last updated before cutoff and returns how many were removed
+func (s *MemorySessionStore) Prune(cutoff time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pruned := 0
+	for key, session := range s.sessions {
+		if session.UpdatedAt.Before(cutoff) {
+			delete(s.sessions, key)
+			pruned++
+		}
+	}
+	return pruned
+}
+
+// ConversationFlow defines the states of a conversation. Each state has a prompt that is sent when the
+// conversation enters it and transitions on the replies to that prompt. A state without transitions ends
+// the conversation.
+type ConversationFlow struct {
+	start  string
+	states map[string]*flowState
+	order  []string
+}
+
+type flowState struct {
+	prompt      string
+	transitions []flowTransition
+	unmatched   string
+	timeout     time.Duration
+	timeoutNext string
+	save        string
+	onLeave     func(ctx context.Context, s *Session, m *IncomingMessage) error
+}
+
+type flowTransition struct {
+	pattern string
+	next    string
+}
+
+// StateOption is an option for a conversation state
+type StateOption func(*flowState)
+
+// OnReply moves to next when the whole reply matches pattern, a regular expression matched ignoring case
+// and surrounding spaces e.g. "yes|y". . matches new lines so a pattern can match replies of several lines.
+func OnReply(pattern, next string) StateOption {
+	return func(s *flowState) {
+		s.transitions = append(s.transitions, flowTransition{pattern: pattern, next: next})
+	}
+}
+
+// OnAnyReply moves to next for any reply that did not match an earlier transition
+func OnAnyReply(next string) StateOption {
+	return OnReply(".*", next)
+}
+
+// OnTimeout moves to next, and sends its prompt, when a reply arrives more than d after the state was entered.
+// The late reply is dropped, it is not applied to next.
+func OnTimeout(d time.Duration, next string) StateOption {
+	return func(s *flowState) {
+		s.timeout = d
+		s.timeoutNext = next
+	}
+}
+
+// Unmatched is sent when a reply matches no transition, defaults to the state's prompt
+func Unmatched(reply string) StateOption {
+	return func(s *flowState) {
+		s.unmatched = reply
+	}
+}
+
+// SaveReply saves the reply that leaves the state in the session data under key
+func SaveReply(key string) StateOption {
+	return func(s *flowState) {
+		s.save = key
+	}
+}
+
+// OnLeave is called with the reply that leaves the state, the conversation stays in the state if it fails
+func OnLeave(f func(ctx context.Context, s *Session, m *IncomingMessage) error) StateOption {
+	return func(s *flowState) {
+		s.onLeave = f
+	}
+}
+
+// NewConversationFlow returns a flow that starts in the start state
+func NewConversationFlow(start string) *ConversationFlow {
+	return &ConversationFlow{start: start, states: map[string]*flowState{}}
+}
+
+// State adds a state to the flow. The prompt is a text/template executed with the session data.
+func (f *ConversationFlow) State(name, prompt string, opts ...StateOption) *ConversationFlow {
+	s := &flowState{prompt: prompt}
+	for _, o := range opts {
+		o(s)
+	}
+	if _, ok := f.states[name]; !ok {
+		f.order = append(f.order, name)
+	}
+	f.states[name] = s
+	return f
+}
+
+// Conversation runs a flow for everyone who messages the account's numbers. The messages of one
+// conversation are handled one at a time, when the store is shared by several processes each process
+// only orders its own messages.
+type Conversation struct {
+	flow        *ConversationFlow
+	prompts     map[string]*template.Template
+	transitions map[string][]compiledTransition
+	store       SessionStore
+	now         func() time.Time
+	mu          sync.Mutex
+	locks       map[SessionKey]*sessionLock
+}
+
+// compiledTransition is a flow transition with its pattern compiled, the flow itself is left untouched
+type compiledTransition struct {
+	re   *regexp.Regexp
+	next string
+}
+
+// sessionLock orders the messages of one conversation, refs counts the messages using it
+type sessionLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// ConversationOption is an option for a conversation
+type ConversationOption func(*Conversation)
+
+// ConversationStore sets where sessions are kept, defaults to a MemorySessionStore
+func ConversationStore(s SessionStore) ConversationOption {
+	return func(c *Conversation) {
+		c.store = s
+	}
+}
+
+// ConversationClock overrides the clock used for state timeouts
+func ConversationClock(now func() time.Time) ConversationOption {
+	return func(c *Conversation) {
+		c.now = now
+	}
+}
+
+// NewConversation checks a flow and returns a conversation that runs it
+func NewConversation(flow *ConversationFlow, opts ...ConversationOption) (*Conversation, error) {
+	if _, ok := flow.states[flow.start]; !ok {
+		return nil, fmt.Errorf("start state %v is not defined", flow.start)
+	}
+
+	c := &Conversation{
+		flow:        flow,
+		prompts:     map[string]*template.Template{},
+		transitions: map[string][]compiledTransition{},
+		store:       NewMemorySessionStore(),
+		now:         time.Now,
+		locks:       map[SessionKey]*sessionLock{},
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	for _, name := range flow.order {
+		s := flow.states[name]
+		tmpl, err := template.New(name).Option("missingkey=zero").Parse(s.prompt)
+		if err != nil {
+			return nil, fmt.Errorf("state %v: %v", name, err)
+		}
+		c.prompts[name] = tmpl
+
+		for _, t := range s.transitions {
+			if _, ok := flow.states[t.next]; !ok {
+				return nil, fmt.Errorf("state %v moves to undefined state %v", name, t.next)
+			}
+			re, err := regexp.Compile(`(?is)^(?:` + t.pattern + `)$`)
+			if err != nil {
+				return nil, fmt.Errorf("state %v: %v", name, err)
+			}
+			c.transitions[name] = append(c.transitions[name], compiledTransition{re: re, next: t.next})
+		}
+		if s.timeoutNext != "" {
+			if _, ok := flow.states[s.timeoutNext]; !ok {
+				return nil, fmt.Errorf("state %v times out to undefined state %v", name, s.timeoutNext)
+			}
+		}
+	}
+	return c, nil
+}
+
+// Start begins a conversation the account starts, it returns the prompt of the start state to send to theirNumber
+func (c *Conversation) Start(ourNumber, theirNumber string, data map[string]string) (string, error) {
+	key := SessionKey{OurNumber: canonicalNumber(ourNumber), TheirNumber: canonicalNumber(theirNumber)}
+	defer c.lock(key)()
+	session := &Session{Data: copyData(data)}
+	return c.enter(key, session, c.flow.start)
+}
+
+// Handle replies to an incoming message, it is a MessageHandler for a MessageRouter. A message from someone
+// without a session starts the conversation, a session in a state the flow does not define is an error.
+func (c *Conversation) Handle(ctx context.Context, m *IncomingMessage) (*twiml.TwiML, error) {
+	key := SessionKey{OurNumber: canonicalNumber(m.To), TheirNumber: canonicalNumber(m.From)}
+	defer c.lock(key)()
+	session, ok, err := c.store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return c.reply(c.enter(key, &Session{Data: map[string]string{}}, c.flow.start))
+	}
+
+	state, ok := c.flow.states[session.State]
+	if !ok {
+		return nil, fmt.Errorf("session of %v is in undefined state %v", key.TheirNumber, session.State)
+	}
+	if state.timeout > 0 && c.now().Sub(session.UpdatedAt) > state.timeout {
+		return c.reply(c.enter(key, session, state.timeoutNext))
+	}
+
+	body := strings.TrimSpace(m.Body)
+	for _, t := range c.transitions[session.State] {
+		if !t.re.MatchString(body) {
+			continue
+		}
+		if state.onLeave != nil {
+			if err := state.onLeave(ctx, session, m); err != nil {
+				return nil, err
+			}
+		}
+		if state.save != "" {
+			session.Data[state.save] = body
+		}
+		return c.reply(c.enter(key, session, t.next))
+	}
+
+	if state.unmatched != "" {
+		return twiml.NewTwiML().Message(state.unmatched), nil
+	}
+	return c.reply(c.render(session.State, session))
+}
+
+// lock waits until no other message of the conversation is being handled, the returned func unlocks it
+func (c *Conversation) lock(key SessionKey) func() {
+	c.mu.Lock()
+	l, ok := c.locks[key]
+	if !ok {
+		l = &sessionLock{}
+		c.locks[key] = l
+	}
+	l.refs++
+	c.mu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		c.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(c.locks, key)
+		}
+		c.mu.Unlock()
+	}
+}
+
+// enter moves a session to a state and returns the state's prompt, the session ends in a state without transitions
+func (c *Conversation) enter(key SessionKey, session *Session, state string) (string, error) {
+	if session.Data == nil {
+		session.Data = map[string]string{}
+	}
+	session.State = state
+	session.UpdatedAt = c.now()
+
+	prompt, err := c.render(state, session)
+	if err != nil {
+		return "", err
+	}
+	if len(c.transitions[state]) == 0 {
+		return prompt, c.store.Delete(key)
+	}
+	return prompt, c.store.Set(key, session)
+}
+
+func (c *Conversation) render(state string, session *Session) (string, error) {
+	var buf bytes.Buffer
+	if err := c.prompts[state].Execute(&buf, session.Data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (c *Conversation) reply(prompt string, err error) (*twiml.TwiML, error) {
+	if err != nil {
+		return nil, err
+	}
+	if prompt == "" {
+		return nil, nil
+	}
+	return twiml.NewTwiML().Message(prompt), nil
+}
+
+func copyData(data map[string]string) map[string]string {
+	copied := make(map[string]string, len(data))
+	for k, v := range data {
+		copied[k] = v
+	}
+	return copied
+}
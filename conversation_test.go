@@ -0,0 +1,226 @@
+package vtwilio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func appointmentFlow(confirmed *[]string) *ConversationFlow {
+	return NewConversationFlow("confirm").
+		State("confirm", "Reply YES to confirm your appointment at {{.time}} or NO to cancel",
+			OnReply("yes|y", "confirmed"),
+			OnReply("no|n", "reason"),
+			Unmatched("Please reply YES or NO"),
+			OnTimeout(time.Hour, "expired"),
+			OnLeave(func(ctx context.Context, s *Session, m *IncomingMessage) error {
+				if strings.EqualFold(strings.TrimSpace(m.Body), "yes") {
+					*confirmed = append(*confirmed, s.Data["time"])
+				}
+				return nil
+			}),
+		).
+		State("reason", "Why are you cancelling?", OnAnyReply("cancelled"), SaveReply("reason")).
+		State("confirmed", "See you at {{.time}}").
+		State("cancelled", "Cancelled: {{.reason}}").
+		State("expired", "This confirmation has expired")
+}
+
+func TestConversation(t *testing.T) {
+	now := time.Date(2023, time.October, 19, 12, 0, 0, 0, time.UTC)
+	confirmed := []string{}
+	store := NewMemorySessionStore()
+	c, err := NewConversation(appointmentFlow(&confirmed), ConversationStore(store), ConversationClock(func() time.Time { return now }))
+	assert.NoError(t, err)
+
+	send := func(from, body string) string {
+		reply, err := c.Handle(context.Background(), &IncomingMessage{From: from, To: "+13065559999", Body: body})
+		assert.NoError(t, err)
+		if reply == nil {
+			return ""
+		}
+		return reply.MessageOpt[0]
+	}
+
+	prompt, err := c.Start("+13065559999", "+13065551234", map[string]string{"time": "3pm"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Reply YES to confirm your appointment at 3pm or NO to cancel", prompt)
+
+	assert.Equal(t, "Please reply YES or NO", send("+13065551234", "maybe"))
+	assert.Equal(t, "See you at 3pm", send("+13065551234", " YES "))
+	assert.Equal(t, []string{"3pm"}, confirmed)
+	_, ok, _ := store.Get(SessionKey{OurNumber: "+13065559999", TheirNumber: "+13065551234"})
+	assert.False(t, ok)
+
+	// a message without a session starts the flow
+	assert.Equal(t, "Reply YES to confirm your appointment at  or NO to cancel", send("+13065554321", "hi"))
+	assert.Equal(t, "Why are you cancelling?", send("+13065554321", "n"))
+	assert.Equal(t, "Cancelled: busy", send("+13065554321", "busy"))
+
+	_, err = c.Start("+13065559999", "+13065551111", map[string]string{"time": "4pm"})
+	assert.NoError(t, err)
+	now = now.Add(2 * time.Hour)
+	assert.Equal(t, "This confirmation has expired", send("+13065551111", "yes"))
+	assert.Equal(t, []string{"3pm"}, confirmed)
+}
+
+func TestConversationOnLeaveError(t *testing.T) {
+	flow := NewConversationFlow("start").
+		State("start", "Reply OK", OnReply("ok", "done"), OnLeave(func(ctx context.Context, s *Session, m *IncomingMessage) error {
+			return fmt.Errorf("booking failed")
+		})).
+		State("done", "Done")
+	c, err := NewConversation(flow)
+	assert.NoError(t, err)
+
+	_, err = c.Start("+13065559999", "+13065551234", nil)
+	assert.NoError(t, err)
+	_, err = c.Handle(context.Background(), &IncomingMessage{From: "+13065551234", To: "+13065559999", Body: "ok"})
+	assert.Equal(t, fmt.Errorf("booking failed"), err)
+
+	reply, err := c.Handle(context.Background(), &IncomingMessage{From: "+13065551234", To: "+13065559999", Body: "nope"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Reply OK"}, reply.MessageOpt)
+}
+
+func TestConversationMultilineReply(t *testing.T) {
+	confirmed := []string{}
+	c, err := NewConversation(appointmentFlow(&confirmed))
+	assert.NoError(t, err)
+
+	_, err = c.Start("+13065559999", "+13065551234", nil)
+	assert.NoError(t, err)
+	_, err = c.Handle(context.Background(), &IncomingMessage{From: "+13065551234", To: "+13065559999", Body: "no"})
+	assert.NoError(t, err)
+	reply, err := c.Handle(context.Background(), &IncomingMessage{From: "+13065551234", To: "+13065559999", Body: "busy\nsorry"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Cancelled: busy\nsorry"}, reply.MessageOpt)
+}
+
+func TestConversationUndefinedState(t *testing.T) {
+	store := NewMemorySessionStore()
+	c, err := NewConversation(appointmentFlow(&[]string{}), ConversationStore(store))
+	assert.NoError(t, err)
+
+	key := SessionKey{OurNumber: "+13065559999", TheirNumber: "+13065551234"}
+	assert.NoError(t, store.Set(key, &Session{State: "removed"}))
+	_, err = c.Handle(context.Background(), &IncomingMessage{From: "+13065551234", To: "+13065559999", Body: "yes"})
+	assert.Equal(t, fmt.Errorf("session of +13065551234 is in undefined state removed"), err)
+	session, ok, _ := store.Get(key)
+	assert.True(t, ok)
+	assert.Equal(t, "removed", session.State)
+}
+
+// slowSessionStore widens the gap between reading and saving a session
+type slowSessionStore struct {
+	*MemorySessionStore
+}
+
+func (s slowSessionStore) Get(key SessionKey) (*Session, bool, error) {
+	session, ok, err := s.MemorySessionStore.Get(key)
+	time.Sleep(5 * time.Millisecond)
+	return session, ok, err
+}
+
+func TestConversationConcurrentReplies(t *testing.T) {
+	flow := NewConversationFlow("s0")
+	for i := 0; i < 5; i++ {
+		flow.State(fmt.Sprintf("s%v", i), "next", OnAnyReply(fmt.Sprintf("s%v", i+1)))
+	}
+	flow.State("s5", "done")
+	store := slowSessionStore{NewMemorySessionStore()}
+	c, err := NewConversation(flow, ConversationStore(store))
+	assert.NoError(t, err)
+
+	_, err = c.Start("+13065559999", "+13065551234", nil)
+	assert.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Handle(context.Background(), &IncomingMessage{From: "+13065551234", To: "+13065559999", Body: "go"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	_, ok, _ := store.Get(SessionKey{OurNumber: "+13065559999", TheirNumber: "+13065551234"})
+	assert.False(t, ok, "every reply moved the conversation on")
+	assert.Empty(t, c.locks)
+}
+
+func TestNewConversationLeavesFlow(t *testing.T) {
+	build := func() *ConversationFlow {
+		return NewConversationFlow("start").
+			State("start", "hi", OnReply("yes", "done"), OnAnyReply("start")).
+			State("done", "bye")
+	}
+	flow := build()
+	first, err := NewConversation(flow)
+	assert.NoError(t, err)
+	second, err := NewConversation(flow)
+	assert.NoError(t, err)
+	assert.Equal(t, build(), flow)
+
+	for _, c := range []*Conversation{first, second} {
+		reply, err := c.Handle(context.Background(), &IncomingMessage{From: "+13065551234", To: "+13065559999", Body: "hello"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"hi"}, reply.MessageOpt)
+		reply, err = c.Handle(context.Background(), &IncomingMessage{From: "+13065551234", To: "+13065559999", Body: "yes"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bye"}, reply.MessageOpt)
+	}
+}
+
+func TestMemorySessionStorePrune(t *testing.T) {
+	now := time.Date(2017, time.August, 31, 12, 0, 0, 0, time.UTC)
+	store := NewMemorySessionStore()
+	stale := SessionKey{OurNumber: "+13065559999", TheirNumber: "+13065551234"}
+	fresh := SessionKey{OurNumber: "+13065559999", TheirNumber: "+13065554321"}
+	assert.NoError(t, store.Set(stale, &Session{State: "start", UpdatedAt: now.Add(-48 * time.Hour)}))
+	assert.NoError(t, store.Set(fresh, &Session{State: "start", UpdatedAt: now.Add(-time.Hour)}))
+
+	assert.Equal(t, 1, store.Prune(now.Add(-24*time.Hour)))
+	_, ok, _ := store.Get(stale)
+	assert.False(t, ok)
+	_, ok, _ = store.Get(fresh)
+	assert.True(t, ok)
+	assert.Equal(t, 0, store.Prune(now.Add(-24*time.Hour)))
+}
+
+func TestNewConversationErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		flow          *ConversationFlow
+		expectedError error
+	}{
+		{
+			name:          "missing start",
+			flow:          NewConversationFlow("start").State("other", "hi"),
+			expectedError: fmt.Errorf("start state start is not defined"),
+		},
+		{
+			name:          "undefined transition",
+			flow:          NewConversationFlow("start").State("start", "hi", OnReply("yes", "missing")),
+			expectedError: fmt.Errorf("state start moves to undefined state missing"),
+		},
+		{
+			name:          "undefined timeout",
+			flow:          NewConversationFlow("start").State("start", "hi", OnAnyReply("start"), OnTimeout(time.Minute, "missing")),
+			expectedError: fmt.Errorf("state start times out to undefined state missing"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewConversation(tt.flow)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package vtwilio
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 )
@@ -15,24 +16,33 @@ type errorMessage struct {
 }
 
 func handleRequest(req *http.Request) ([]byte, error) {
+	bodyBytes, _, err := doRequest(req)
+	return bodyBytes, err
+}
+
+// doRequest is handleRequest that also returns Twilio's error when the request is rejected
+func doRequest(req *http.Request) ([]byte, *errorMessage, error) {
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		var err errorMessage
 		json.Unmarshal(bodyBytes, &err)
-		return nil, fmt.Errorf("Error: %v", err.Message)
+		if err.Status == 0 {
+			err.Status = resp.StatusCode
+		}
+		return nil, &err, fmt.Errorf("Error: %v", err.Message)
 	}
 
-	return bodyBytes, nil
+	return bodyBytes, nil, nil
 }
 
 func setUpRequest(req *http.Request, accountSID, authToken string) {
@@ -42,18 +52,24 @@ func setUpRequest(req *http.Request, accountSID, authToken string) {
 }
 
 func handleMessage(req *http.Request) (*Message, error) {
-	bodyBytes, err := handleRequest(req)
+	m, _, err := handleMessageRejection(req)
+	return m, err
+}
+
+// handleMessageRejection is handleMessage that also returns Twilio's error when the message is rejected
+func handleMessageRejection(req *http.Request) (*Message, *errorMessage, error) {
+	bodyBytes, rejection, err := doRequest(req)
 	if err != nil {
-		return nil, err
+		return nil, rejection, err
 	}
 
 	var data Message
 	err = json.Unmarshal(bodyBytes, &data)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &data, nil
+	return &data, nil, nil
 }
 
 func handleListMessages(req *http.Request) (*List, error) {
@@ -86,6 +102,21 @@ func handleAvailability(req *http.Request) (*AvailablePhoneNumbers, error) {
 	return &data, nil
 }
 
+func handleAvailableCountries(req *http.Request) (*AvailableCountries, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data AvailableCountries
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
 func handleIncomingPhoneNumbers(req *http.Request) (*IncomingPhoneNumber, error) {
 	bodyBytes, err := handleRequest(req)
 	if err != nil {
@@ -101,6 +132,239 @@ func handleIncomingPhoneNumbers(req *http.Request) (*IncomingPhoneNumber, error)
 	return &data, nil
 }
 
+func handleListIncomingPhoneNumbers(req *http.Request) (*IncomingPhoneNumberList, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data IncomingPhoneNumberList
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleLookup(req *http.Request) (*Lookup, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data Lookup
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleCall(req *http.Request) (*Call, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data Call
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleListCalls(req *http.Request) (*CallList, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data CallList
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleRecording(req *http.Request) (*Recording, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data Recording
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleListRecordings(req *http.Request) (*RecordingList, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data RecordingList
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleConference(req *http.Request) (*Conference, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data Conference
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleListConferences(req *http.Request) (*ConferenceList, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data ConferenceList
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleParticipant(req *http.Request) (*Participant, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data Participant
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleListParticipants(req *http.Request) (*ParticipantList, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data ParticipantList
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleQueue(req *http.Request) (*Queue, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data Queue
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleListQueues(req *http.Request) (*QueueList, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data QueueList
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleQueueMember(req *http.Request) (*QueueMember, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data QueueMember
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func handleListQueueMembers(req *http.Request) (*QueueMemberList, error) {
+	bodyBytes, err := handleRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data QueueMemberList
+	err = json.Unmarshal(bodyBytes, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// handleStream copies a successful response body to w instead of reading it into memory
+func handleStream(req *http.Request, w io.Writer) error {
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		bodyBytes, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		var msg errorMessage
+		json.Unmarshal(bodyBytes, &msg)
+		return fmt.Errorf("Error: %v", msg.Message)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
 func genericHandler(req *http.Request) error {
 	if _, err := handleRequest(req); err != nil {
 		return err
@@ -6,9 +6,12 @@ import (
 	"time"
 )
 
+// twilioTimeFormat is the RFC 2822 format Twilio uses for times
+const twilioTimeFormat = "Mon, 2 Jan 2006 15:04:05 +0000"
+
 // ToTime convers a twilio api time response to time.Time
 func ToTime(timeStr string) (time.Time, error) {
-	t, err := time.Parse("Mon, 2 Jan 2006 15:04:05 +0000", timeStr)
+	t, err := time.Parse(twilioTimeFormat, timeStr)
 	if err != nil {
 		return time.Time{}, err
 	}
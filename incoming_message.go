@@ -0,0 +1,115 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// maxIncomingMedia is the most media files Twilio attaches to one message
+const maxIncomingMedia = 10
+
+// IncomingMessage is a message received by one of the account's numbers, sent to the number's SMS webhook
+type IncomingMessage struct {
+	MessageSID          string
+	AccountSID          string
+	MessagingServiceSID string
+	From                string
+	To                  string
+	Body                string
+	NumSegments         int
+	Media               []IncomingMedia
+	FromCity            string
+	FromState           string
+	FromZip             string
+	FromCountry         string
+	ToCity              string
+	ToState             string
+	ToZip               string
+	ToCountry           string
+	SMSStatus           string
+	APIVersion          string
+}
+
+// IncomingMedia is a file attached to an incoming MMS
+type IncomingMedia struct {
+	URL         string
+	ContentType string
+}
+
+// ParseIncomingMessage parses the parameters of a request to an SMS webhook. Twilio sends them as a form
+// for POST webhooks and in the query string for GET webhooks.
+func ParseIncomingMessage(r *http.Request) (*IncomingMessage, error) {
+	if r.Method != "POST" && r.Method != "GET" {
+		return nil, fmt.Errorf("unsupported method %v", r.Method)
+	}
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("invalid message parameters: %v", err)
+	}
+
+	form := r.Form
+	m := &IncomingMessage{
+		MessageSID:          form.Get("MessageSid"),
+		AccountSID:          form.Get("AccountSid"),
+		MessagingServiceSID: form.Get("MessagingServiceSid"),
+		From:                form.Get("From"),
+		To:                  form.Get("To"),
+		Body:                form.Get("Body"),
+		FromCity:            form.Get("FromCity"),
+		FromState:           form.Get("FromState"),
+		FromZip:             form.Get("FromZip"),
+		FromCountry:         form.Get("FromCountry"),
+		ToCity:              form.Get("ToCity"),
+		ToState:             form.Get("ToState"),
+		ToZip:               form.Get("ToZip"),
+		ToCountry:           form.Get("ToCountry"),
+		SMSStatus:           form.Get("SmsStatus"),
+		APIVersion:          form.Get("ApiVersion"),
+	}
+
+	if m.MessageSID == "" {
+		return nil, fmt.Errorf("MessageSid is required")
+	}
+	if !strings.HasPrefix(m.MessageSID, "SM") && !strings.HasPrefix(m.MessageSID, "MM") {
+		return nil, fmt.Errorf("invalid MessageSid %v", m.MessageSID)
+	}
+	if m.From == "" {
+		return nil, fmt.Errorf("From is required")
+	}
+	if m.To == "" {
+		return nil, fmt.Errorf("To is required")
+	}
+
+	if s := form.Get("NumSegments"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid NumSegments %v", s)
+		}
+		m.NumSegments = n
+	}
+
+	numMedia := 0
+	if s := form.Get("NumMedia"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 || n > maxIncomingMedia {
+			return nil, fmt.Errorf("invalid NumMedia %v", s)
+		}
+		numMedia = n
+	}
+	m.Media = make([]IncomingMedia, 0, numMedia)
+	for i := 0; i < numMedia; i++ {
+		media := IncomingMedia{
+			URL:         form.Get(fmt.Sprintf("MediaUrl%v", i)),
+			ContentType: form.Get(fmt.Sprintf("MediaContentType%v", i)),
+		}
+		if media.URL == "" {
+			return nil, fmt.Errorf("NumMedia is %v but MediaUrl%v is missing", numMedia, i)
+		}
+		if media.ContentType == "" {
+			return nil, fmt.Errorf("NumMedia is %v but MediaContentType%v is missing", numMedia, i)
+		}
+		m.Media = append(m.Media, media)
+	}
+	return m, nil
+}
@@ -0,0 +1,116 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIncomingMessage(t *testing.T) {
+	valid := func() url.Values {
+		return url.Values{
+			"MessageSid":        {"MM123"},
+			"AccountSid":        {"AC123"},
+			"From":              {"+13065551234"},
+			"To":                {"+13065554321"},
+			"Body":              {"hello"},
+			"NumSegments":       {"1"},
+			"NumMedia":          {"2"},
+			"MediaUrl0":         {"https://api.twilio.com/media/ME1"},
+			"MediaUrl1":         {"https://api.twilio.com/media/ME2"},
+			"FromCity":          {"SASKATOON"},
+			"FromState":         {"SK"},
+			"FromZip":           {""},
+			"FromCountry":       {"CA"},
+			"SmsStatus":         {"received"},
+			"MediaContentType0": {"image/jpeg"},
+			"MediaContentType1": {"video/mp4"},
+		}
+	}
+	without := func(keys ...string) url.Values {
+		v := valid()
+		for _, k := range keys {
+			v.Del(k)
+		}
+		return v
+	}
+	with := func(k, value string) url.Values {
+		v := valid()
+		v.Set(k, value)
+		return v
+	}
+
+	expected := &IncomingMessage{
+		MessageSID:  "MM123",
+		AccountSID:  "AC123",
+		From:        "+13065551234",
+		To:          "+13065554321",
+		Body:        "hello",
+		NumSegments: 1,
+		Media: []IncomingMedia{
+			{URL: "https://api.twilio.com/media/ME1", ContentType: "image/jpeg"},
+			{URL: "https://api.twilio.com/media/ME2", ContentType: "video/mp4"},
+		},
+		FromCity:    "SASKATOON",
+		FromState:   "SK",
+		FromCountry: "CA",
+		SMSStatus:   "received",
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		params        url.Values
+		expected      *IncomingMessage
+		expectedError error
+	}{
+		{name: "post", method: "POST", params: valid(), expected: expected},
+		{name: "get", method: "GET", params: valid(), expected: expected},
+		{
+			name:   "no media",
+			method: "POST",
+			params: without("NumMedia", "MediaUrl0", "MediaUrl1", "MediaContentType0", "MediaContentType1", "NumSegments"),
+			expected: &IncomingMessage{
+				MessageSID:  "MM123",
+				AccountSID:  "AC123",
+				From:        "+13065551234",
+				To:          "+13065554321",
+				Body:        "hello",
+				Media:       []IncomingMedia{},
+				FromCity:    "SASKATOON",
+				FromState:   "SK",
+				FromCountry: "CA",
+				SMSStatus:   "received",
+			},
+		},
+		{name: "bad method", method: "PUT", params: valid(), expectedError: fmt.Errorf("unsupported method PUT")},
+		{name: "missing sid", method: "POST", params: without("MessageSid"), expectedError: fmt.Errorf("MessageSid is required")},
+		{name: "call sid", method: "POST", params: with("MessageSid", "CA123"), expectedError: fmt.Errorf("invalid MessageSid CA123")},
+		{name: "missing from", method: "POST", params: without("From"), expectedError: fmt.Errorf("From is required")},
+		{name: "missing to", method: "POST", params: without("To"), expectedError: fmt.Errorf("To is required")},
+		{name: "bad segments", method: "POST", params: with("NumSegments", "one"), expectedError: fmt.Errorf("invalid NumSegments one")},
+		{name: "bad media count", method: "POST", params: with("NumMedia", "-1"), expectedError: fmt.Errorf("invalid NumMedia -1")},
+		{name: "too many media", method: "POST", params: with("NumMedia", "11"), expectedError: fmt.Errorf("invalid NumMedia 11")},
+		{name: "huge media count", method: "POST", params: with("NumMedia", "9223372036854775807"), expectedError: fmt.Errorf("invalid NumMedia 9223372036854775807")},
+		{name: "missing media url", method: "POST", params: without("MediaUrl1"), expectedError: fmt.Errorf("NumMedia is 2 but MediaUrl1 is missing")},
+		{name: "missing content type", method: "POST", params: without("MediaContentType0"), expectedError: fmt.Errorf("NumMedia is 2 but MediaContentType0 is missing")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/sms?"+tt.params.Encode(), nil)
+			if tt.method == "POST" {
+				r = httptest.NewRequest(tt.method, "/sms", strings.NewReader(tt.params.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			}
+
+			actual, err := ParseIncomingMessage(r)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -1,25 +1,30 @@
 package vtwilio
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+
+	"github.com/twiebe-va/vtwilio-go/phonenumber"
 )
 
 // IncomingPhoneNumber purchase an incoming phone number
 func (v *VTwilio) IncomingPhoneNumber(number string, opts ...IncomingPhoneNumberOption) (*IncomingPhoneNumber, error) {
-	return v.incomingPhoneNumber(number, "", opts...)
+	return v.incomingPhoneNumber(context.Background(), number, "", opts...)
 }
 
 // UpdateIncomingPhoneNumber updates an existing phone numbers info
 func (v *VTwilio) UpdateIncomingPhoneNumber(number, sid string, opts ...IncomingPhoneNumberOption) (*IncomingPhoneNumber, error) {
-	return v.incomingPhoneNumber(number, sid, opts...)
+	return v.incomingPhoneNumber(context.Background(), number, sid, opts...)
 }
 
-func (v *VTwilio) incomingPhoneNumber(number, sid string, opts ...IncomingPhoneNumberOption) (*IncomingPhoneNumber, error) {
-	if err := validateNumber(number); err != nil {
+func (v *VTwilio) incomingPhoneNumber(ctx context.Context, number, sid string, opts ...IncomingPhoneNumberOption) (*IncomingPhoneNumber, error) {
+	number, err := v.normalizeNumber(number)
+	if err != nil {
 		return nil, err
 	}
 	config := &incomingNumberConfiguration{
@@ -37,7 +42,7 @@ func (v *VTwilio) incomingPhoneNumber(number, sid string, opts ...IncomingPhoneN
 		return nil, err
 	}
 	setUpRequest(req, v.accountSID, v.authToken)
-	return handleIncomingPhoneNumbers(req)
+	return handleIncomingPhoneNumbers(req.WithContext(ctx))
 }
 
 func buildIncomingPhoneNumber(api, accountSID, sid string) string {
@@ -49,11 +54,117 @@ func buildIncomingPhoneNumber(api, accountSID, sid string) string {
 	return urlStr
 }
 
+// ListIncomingPhoneNumbers returns a page of the phone numbers owned by the account
+func (v *VTwilio) ListIncomingPhoneNumbers(opts ...IncomingPhoneNumberListOption) (*IncomingPhoneNumberList, error) {
+	c := &incomingNumberListConfiguration{
+		PageSize: 10,
+		Page:     0,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	values := url.Values{}
+	values.Set("PageSize", strconv.Itoa(c.PageSize))
+	values.Set("Page", strconv.Itoa(c.Page))
+	if c.FriendlyName != "" {
+		values.Set("FriendlyName", c.FriendlyName)
+	}
+	if c.PhoneNumber != "" {
+		values.Set("PhoneNumber", c.PhoneNumber)
+	}
+	if c.Beta != "" {
+		values.Set("Beta", c.Beta)
+	}
+	if c.Origin != "" {
+		values.Set("Origin", string(c.Origin))
+	}
+
+	urlStr := fmt.Sprintf("%s?%s", buildIncomingPhoneNumber(v.baseAPI, v.accountSID, ""), values.Encode())
+	return v.listIncomingPhoneNumbers(urlStr)
+}
+
+func (v *VTwilio) listIncomingPhoneNumbers(urlStr string) (*IncomingPhoneNumberList, error) {
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleListIncomingPhoneNumbers(req)
+}
+
+// allIncomingPhoneNumbers reads every page of the account's phone numbers
+func (v *VTwilio) allIncomingPhoneNumbers() ([]*IncomingPhoneNumber, error) {
+	numbers := []*IncomingPhoneNumber{}
+	list, err := v.ListIncomingPhoneNumbers(IncomingNumbersPageSize(1000))
+	for {
+		if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, list.IncomingPhoneNumbers...)
+		if list.NextPageURI == "" {
+			return numbers, nil
+		}
+		next, perr := v.pageURL(list.NextPageURI)
+		if perr != nil {
+			return nil, perr
+		}
+		list, err = v.listIncomingPhoneNumbers(next)
+	}
+}
+
+// GetIncomingPhoneNumber gets one of the account's phone numbers by its sid
+func (v *VTwilio) GetIncomingPhoneNumber(sid string) (*IncomingPhoneNumber, error) {
+	if sid == "" {
+		return nil, fmt.Errorf("invalid sid")
+	}
+
+	req, err := http.NewRequest("GET", buildIncomingPhoneNumber(v.baseAPI, v.accountSID, sid), nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleIncomingPhoneNumbers(req)
+}
+
+// FindIncomingPhoneNumber gets one of the account's phone numbers by the number itself
+func (v *VTwilio) FindIncomingPhoneNumber(number string) (*IncomingPhoneNumber, error) {
+	number, err := v.normalizeNumber(number)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := v.ListIncomingPhoneNumbers(IncomingNumbersMatching(number))
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range list.IncomingPhoneNumbers {
+		if n.PhoneNumber == number {
+			return n, nil
+		}
+	}
+	return nil, fmt.Errorf("account does not own the number %v", number)
+}
+
 // ReleaseNumber "deletes" a number. This number could be used by someone else.
-func (v *VTwilio) ReleaseNumber(sid string) error {
+// Protected numbers are only released with OverrideProtection.
+func (v *VTwilio) ReleaseNumber(sid string, opts ...ReleaseOption) error {
 	if sid == "" {
 		return fmt.Errorf("invalid sid")
 	}
+	c := &releaseConfiguration{}
+	for _, o := range opts {
+		o(c)
+	}
+	if v.protectionEnabled() && !c.OverrideProtection {
+		n, err := v.GetIncomingPhoneNumber(sid)
+		if err != nil {
+			return err
+		}
+		if err := v.checkProtection(n); err != nil {
+			return err
+		}
+	}
 
 	urlStr := buildIncomingPhoneNumber(v.baseAPI, v.accountSID, sid)
 	req, err := http.NewRequest("DELETE", urlStr, nil)
@@ -64,21 +175,9 @@ func (v *VTwilio) ReleaseNumber(sid string) error {
 	return genericHandler(req)
 }
 
-func validateNumber(n string) error {
-	if n == "" {
-		return fmt.Errorf("phone number is required")
-	}
-	if n[0] != '+' {
-		return fmt.Errorf("phone number must begin with +")
-	}
-	if strings.Contains(n, "-") {
-		return fmt.Errorf("phone number must be numbers")
-	}
-	if len(n) > 16 {
-		return fmt.Errorf("number can only contain 15 digits")
-	}
-
-	return nil
+// normalizeNumber parses a phone number and returns it in E.164 format
+func (v *VTwilio) normalizeNumber(n string) (string, error) {
+	return phonenumber.Normalize(n, v.region)
 }
 
 func buildPostValues(c *incomingNumberConfiguration) string {
@@ -194,3 +194,67 @@ func AddressSID(sid string) IncomingPhoneNumberOption {
 		i.AddressSID = sid
 	}
 }
+
+// NumberOrigin is where a phone number came from
+type NumberOrigin string
+
+const (
+	// TwilioOrigin numbers were bought from Twilio
+	TwilioOrigin NumberOrigin = "twilio"
+	// HostedOrigin numbers are hosted on Twilio but owned by another carrier
+	HostedOrigin NumberOrigin = "hosted"
+)
+
+type incomingNumberListConfiguration struct {
+	FriendlyName string
+	PhoneNumber  string
+	Beta         string
+	Origin       NumberOrigin
+	PageSize     int
+	Page         int
+}
+
+// IncomingPhoneNumberListOption is an option for listing the account's phone numbers
+type IncomingPhoneNumberListOption func(*incomingNumberListConfiguration)
+
+// IncomingNumbersNamed only lists numbers with the friendly name
+func IncomingNumbersNamed(name string) IncomingPhoneNumberListOption {
+	return func(c *incomingNumberListConfiguration) {
+		c.FriendlyName = name
+	}
+}
+
+// IncomingNumbersMatching only lists numbers that contain the digits, use * to match any digit e.g. "306*******"
+func IncomingNumbersMatching(number string) IncomingPhoneNumberListOption {
+	return func(c *incomingNumberListConfiguration) {
+		c.PhoneNumber = number
+	}
+}
+
+// IncomingNumbersBeta sets whether numbers new to Twilio are listed, Twilio defaults to true
+func IncomingNumbersBeta(b bool) IncomingPhoneNumberListOption {
+	return func(c *incomingNumberListConfiguration) {
+		c.Beta = strconv.FormatBool(b)
+	}
+}
+
+// IncomingNumbersFromOrigin only lists numbers with the origin
+func IncomingNumbersFromOrigin(o NumberOrigin) IncomingPhoneNumberListOption {
+	return func(c *incomingNumberListConfiguration) {
+		c.Origin = o
+	}
+}
+
+// IncomingNumbersPageSize sets the page size, defaults to 10
+func IncomingNumbersPageSize(pageSize int) IncomingPhoneNumberListOption {
+	return func(c *incomingNumberListConfiguration) {
+		c.PageSize = pageSize
+	}
+}
+
+// IncomingNumbersPage sets the page number, defaults to 0
+func IncomingNumbersPage(page int) IncomingPhoneNumberListOption {
+	return func(c *incomingNumberListConfiguration) {
+		c.Page = page
+	}
+}
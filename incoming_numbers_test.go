@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -98,12 +99,28 @@ func TestIncomingNumbersHappyPath(t *testing.T) {
 				},
 			},
 			expected:      nil,
-			expectedError: fmt.Errorf("phone number must begin with +"),
+			expectedError: fmt.Errorf("phone number must begin with + or a default region must be set"),
 		},
 		{
-			name: "fails with dashes in number",
+			name: "national number with a default region",
 			in: testIncomingPhoneNumberArgs{
-				number:  "+1-234-567-8910",
+				number:  "(234) 567-8910",
+				options: nil,
+				vTwilio: &VTwilio{
+					accountSID:   "sid",
+					authToken:    "token",
+					twilioNumber: "+12345678910",
+					baseAPI:      fmt.Sprintf("%s/", ts.URL),
+					region:       "US",
+				},
+			},
+			expected:      expected,
+			expectedError: nil,
+		},
+		{
+			name: "fails with letters in number",
+			in: testIncomingPhoneNumberArgs{
+				number:  "+1-234-567-CALL",
 				options: nil,
 				vTwilio: &VTwilio{
 					accountSID:   "sid",
@@ -113,7 +130,7 @@ func TestIncomingNumbersHappyPath(t *testing.T) {
 				},
 			},
 			expected:      nil,
-			expectedError: fmt.Errorf("phone number must be numbers"),
+			expectedError: fmt.Errorf("phone number contains invalid character 'C'"),
 		},
 		{
 			name: "fails with more than 15 digets",
@@ -544,3 +561,91 @@ func TestBuildPostValues(t *testing.T) {
 		})
 	}
 }
+
+func TestListIncomingPhoneNumbers(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []IncomingPhoneNumberListOption
+		expectedQuery url.Values
+	}{
+		{
+			name:          "defaults",
+			expectedQuery: url.Values{"PageSize": {"10"}, "Page": {"0"}},
+		},
+		{
+			name: "filters",
+			opts: []IncomingPhoneNumberListOption{
+				IncomingNumbersNamed("support"),
+				IncomingNumbersMatching("+1306*******"),
+				IncomingNumbersBeta(false),
+				IncomingNumbersFromOrigin(HostedOrigin),
+				IncomingNumbersPageSize(50),
+				IncomingNumbersPage(3),
+			},
+			expectedQuery: url.Values{
+				"FriendlyName": {"support"},
+				"PhoneNumber":  {"+1306*******"},
+				"Beta":         {"false"},
+				"Origin":       {"hosted"},
+				"PageSize":     {"50"},
+				"Page":         {"3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/sid/IncomingPhoneNumbers.json", r.URL.Path)
+				assert.Equal(t, tt.expectedQuery, r.URL.Query())
+				fmt.Fprint(w, `{"page_size": 10, "incoming_phone_numbers": [{"sid": "PN123", "phone_number": "+13065551234", "origin": "twilio"}]}`)
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+			actual, err := v.ListIncomingPhoneNumbers(tt.opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, &IncomingPhoneNumberList{
+				PageSize:             10,
+				IncomingPhoneNumbers: []*IncomingPhoneNumber{{SID: "PN123", PhoneNumber: "+13065551234", Origin: TwilioOrigin}},
+			}, actual)
+		})
+	}
+}
+
+func TestGetIncomingPhoneNumber(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/sid/IncomingPhoneNumbers/PN123.json", r.URL.Path)
+		fmt.Fprint(w, `{"sid": "PN123", "phone_number": "+13065551234", "sms_url": "http://url.com/sms"}`)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	actual, err := v.GetIncomingPhoneNumber("PN123")
+	assert.NoError(t, err)
+	assert.Equal(t, &IncomingPhoneNumber{SID: "PN123", PhoneNumber: "+13065551234", SMSURL: "http://url.com/sms"}, actual)
+
+	_, err = v.GetIncomingPhoneNumber("")
+	assert.Equal(t, fmt.Errorf("invalid sid"), err)
+}
+
+func TestFindIncomingPhoneNumber(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("PhoneNumber") != "+13065551234" {
+			fmt.Fprint(w, `{"incoming_phone_numbers": []}`)
+			return
+		}
+		fmt.Fprint(w, `{"incoming_phone_numbers": [{"sid": "PN999", "phone_number": "+130655512345"}, {"sid": "PN123", "phone_number": "+13065551234"}]}`)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL), region: "CA"}
+	actual, err := v.FindIncomingPhoneNumber("(306) 555-1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "PN123", actual.SID)
+
+	_, err = v.FindIncomingPhoneNumber("+13065550000")
+	assert.Equal(t, fmt.Errorf("account does not own the number +13065550000"), err)
+}
@@ -0,0 +1,303 @@
+package vtwilio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/twiebe-va/vtwilio-go/twiml"
+)
+
+const (
+	defaultIVRAttempts = 3
+	defaultIVRInvalid  = "Sorry, that is not a valid option."
+)
+
+// IVR is a phone menu made of named nodes. A node with options plays its prompt and waits for the caller
+// to choose one, any other node plays its prompt and then dials, enqueues or redirects the call or hangs up.
+type IVR struct {
+	Start    string              `json:"start"`
+	Voice    twiml.Voice         `json:"voice,omitempty"`
+	Language twiml.Language      `json:"language,omitempty"`
+	Nodes    map[string]*IVRNode `json:"nodes"`
+}
+
+// IVRNode is a menu or an action of an IVR
+type IVRNode struct {
+	Say     string       `json:"say"`
+	Options []*IVRChoice `json:"options,omitempty"`
+	// Timeout is the seconds to wait for input, Twilio defaults to 5
+	Timeout int `json:"timeout,omitempty"`
+	// Attempts is how many times the menu is played before the default route, defaults to 3
+	Attempts int `json:"attempts,omitempty"`
+	// Invalid is said when the caller chooses something that is not an option
+	Invalid string `json:"invalid,omitempty"`
+	// Default is the node the call moves to when the caller runs out of attempts, the call hangs up when it is empty
+	Default string `json:"default,omitempty"`
+
+	Dial     string `json:"dial,omitempty"`
+	Enqueue  string `json:"enqueue,omitempty"`
+	Redirect string `json:"redirect,omitempty"`
+}
+
+// IVRChoice is an option of a menu, the caller chooses it by pressing Digits or saying one of Phrases
+type IVRChoice struct {
+	Digits  string   `json:"digits,omitempty"`
+	Phrases []string `json:"phrases,omitempty"`
+	Next    string   `json:"next"`
+}
+
+// ParseIVR reads an IVR defined in JSON
+func ParseIVR(data []byte) (*IVR, error) {
+	ivr := &IVR{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(ivr); err != nil {
+		return nil, err
+	}
+	return ivr, ivr.Validate()
+}
+
+// LoadIVR reads an IVR from a JSON file
+func LoadIVR(path string) (*IVR, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIVR(data)
+}
+
+// Validate checks that every node the IVR routes to exists and that menu options are unambiguous
+func (ivr *IVR) Validate() error {
+	if _, ok := ivr.Nodes[ivr.Start]; !ok {
+		return fmt.Errorf("start node %v is not defined", ivr.Start)
+	}
+	for name, n := range ivr.Nodes {
+		if n == nil {
+			return fmt.Errorf("node %v is empty", name)
+		}
+		actions := 0
+		for _, a := range []string{n.Dial, n.Enqueue, n.Redirect} {
+			if a != "" {
+				actions++
+			}
+		}
+		if actions > 1 {
+			return fmt.Errorf("node %v can only dial, enqueue or redirect", name)
+		}
+		if actions > 0 && len(n.Options) > 0 {
+			return fmt.Errorf("node %v has options and an action", name)
+		}
+		if n.Default != "" {
+			if _, ok := ivr.Nodes[n.Default]; !ok {
+				return fmt.Errorf("node %v defaults to undefined node %v", name, n.Default)
+			}
+		}
+
+		digits := map[string]bool{}
+		for i, o := range n.Options {
+			if o == nil {
+				return fmt.Errorf("option %v of node %v is empty", i, name)
+			}
+			if o.Digits == "" && len(o.Phrases) == 0 {
+				return fmt.Errorf("option %v of node %v needs digits or phrases", i, name)
+			}
+			if strings.Trim(o.Digits, "0123456789*#") != "" {
+				return fmt.Errorf("option %v of node %v has invalid digits %v", i, name, o.Digits)
+			}
+			if o.Digits != "" && digits[o.Digits] {
+				return fmt.Errorf("node %v has more than one option for %v", name, o.Digits)
+			}
+			digits[o.Digits] = true
+			if _, ok := ivr.Nodes[o.Next]; !ok {
+				return fmt.Errorf("option %v of node %v moves to undefined node %v", i, name, o.Next)
+			}
+		}
+	}
+	return nil
+}
+
+// IVRHandler is an http.Handler for a voice webhook that runs an IVR. The node and attempt are carried
+// in the query string of the Gather action so the handler keeps no state between requests.
+type IVRHandler struct {
+	ivr *IVR
+}
+
+// NewIVRHandler validates an IVR and returns a handler for it
+func NewIVRHandler(ivr *IVR) (*IVRHandler, error) {
+	if err := ivr.Validate(); err != nil {
+		return nil, err
+	}
+	return &IVRHandler{ivr: ivr}, nil
+}
+
+// ServeHTTP plays the node in the request, or routes the Digits or SpeechResult the caller gave to it
+func (h *IVRHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	name := r.URL.Query().Get("node")
+	if name == "" {
+		name = h.ivr.Start
+	}
+	node, ok := h.ivr.Nodes[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown node %v", name), http.StatusBadRequest)
+		return
+	}
+	if r.URL.Query().Get("step") != "input" {
+		writeTwiML(w, h.render(name, 1, ""))
+		return
+	}
+
+	attempt, _ := strconv.Atoi(r.URL.Query().Get("attempt"))
+	digits := r.Form.Get("Digits")
+	speech := r.Form.Get("SpeechResult")
+	if choice := node.choose(digits, speech); choice != nil {
+		writeTwiML(w, h.render(choice.Next, 1, ""))
+		return
+	}
+
+	attempts := node.Attempts
+	if attempts <= 0 {
+		attempts = defaultIVRAttempts
+	}
+	if attempt >= attempts {
+		if node.Default == "" {
+			writeTwiML(w, nil)
+			return
+		}
+		writeTwiML(w, h.render(node.Default, 1, ""))
+		return
+	}
+
+	invalid := ""
+	if digits != "" || speech != "" {
+		invalid = node.Invalid
+		if invalid == "" {
+			invalid = defaultIVRInvalid
+		}
+	}
+	writeTwiML(w, h.render(name, attempt+1, invalid))
+}
+
+// render builds the TwiML for a node, invalid is said before the prompt of a menu
+func (h *IVRHandler) render(name string, attempt int, invalid string) *twiml.TwiML {
+	node := h.ivr.Nodes[name]
+	t := twiml.NewTwiML()
+
+	if len(node.Options) == 0 {
+		if node.Say != "" {
+			t.Say(node.Say, h.sayOptions()...)
+		}
+		switch {
+		case node.Dial != "":
+			t.Dial(twiml.DialNumber(node.Dial))
+		case node.Enqueue != "":
+			t.Enqueue(node.Enqueue)
+		case node.Redirect != "":
+			t.Redirect(node.Redirect)
+		}
+		return t
+	}
+
+	if invalid != "" {
+		t.Say(invalid, h.sayOptions()...)
+	}
+	action := "?" + url.Values{"node": {name}, "attempt": {strconv.Itoa(attempt)}, "step": {"input"}}.Encode()
+	opts := []twiml.GatherOption{
+		twiml.GatherAction(action),
+		twiml.GatherMethod(twiml.POST),
+		twiml.GatherSay(node.Say, h.sayOptions()...),
+	}
+	if node.Timeout > 0 {
+		opts = append(opts, twiml.GatherTimeout(node.Timeout))
+	}
+	if n := node.numDigits(); n > 0 {
+		opts = append(opts, twiml.GatherNumDigits(n))
+	}
+	if hints := node.phrases(); len(hints) > 0 {
+		opts = append(opts, twiml.GatherInput(twiml.DTMF, twiml.Speech), twiml.GatherHints(hints...))
+		if h.ivr.Language != "" {
+			opts = append(opts, twiml.GatherLanguage(h.ivr.Language))
+		}
+	}
+	// Twilio continues with the next verb when the caller gives no input
+	return t.Gather(opts...).Redirect(action)
+}
+
+func (h *IVRHandler) sayOptions() []twiml.SayOption {
+	opts := []twiml.SayOption{}
+	if h.ivr.Voice != "" {
+		opts = append(opts, twiml.SayVoice(h.ivr.Voice))
+	}
+	if h.ivr.Language != "" {
+		opts = append(opts, twiml.SayLanguage(h.ivr.Language))
+	}
+	return opts
+}
+
+// choose returns the option the caller pressed or said, digits are matched exactly and speech
+// matches an option when it contains one of the option's phrases
+func (n *IVRNode) choose(digits, speech string) *IVRChoice {
+	if digits != "" {
+		for _, o := range n.Options {
+			if o.Digits == digits {
+				return o
+			}
+		}
+		return nil
+	}
+
+	said := " " + normalizeSpeech(speech) + " "
+	if strings.TrimSpace(said) == "" {
+		return nil
+	}
+	for _, o := range n.Options {
+		for _, p := range o.Phrases {
+			if strings.Contains(said, " "+normalizeSpeech(p)+" ") {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+// numDigits returns the length of the options' digits when they are all the same length, so the
+// gather ends as soon as the caller presses an option
+func (n *IVRNode) numDigits() int {
+	length := 0
+	for _, o := range n.Options {
+		if o.Digits == "" {
+			continue
+		}
+		if length != 0 && len(o.Digits) != length {
+			return 0
+		}
+		length = len(o.Digits)
+	}
+	return length
+}
+
+func (n *IVRNode) phrases() []string {
+	phrases := []string{}
+	for _, o := range n.Options {
+		phrases = append(phrases, o.Phrases...)
+	}
+	return phrases
+}
+
+// normalizeSpeech lower cases speech and replaces punctuation with spaces
+func normalizeSpeech(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
@@ -0,0 +1,170 @@
+package vtwilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testIVR = `{
+	"start": "main",
+	"voice": "Alice",
+	"nodes": {
+		"main": {
+			"say": "Press 1 or say sales for sales, press 2 or say support for support",
+			"options": [
+				{"digits": "1", "phrases": ["sales"], "next": "sales"},
+				{"digits": "2", "phrases": ["support", "help"], "next": "support"}
+			],
+			"timeout": 3,
+			"attempts": 2,
+			"default": "operator"
+		},
+		"sales": {"say": "Connecting you to sales", "dial": "+13065551234"},
+		"support": {"say": "Please hold", "enqueue": "support"},
+		"operator": {"say": "Transferring you to an operator", "redirect": "/operator"}
+	}
+}`
+
+func TestIVRHandler(t *testing.T) {
+	ivr, err := ParseIVR([]byte(testIVR))
+	assert.NoError(t, err)
+	h, err := NewIVRHandler(ivr)
+	assert.NoError(t, err)
+
+	menu := func(attempt int) string {
+		return fmt.Sprintf(`<Gather action="?attempt=%[1]v&amp;node=main&amp;step=input" method="POST" input="dtmf speech" timeout="3" numDigits="1" hints="sales, support, help">`+
+			"\n\t\t"+`<Say voice="Alice">Press 1 or say sales for sales, press 2 or say support for support</Say>`+
+			"\n\t</Gather>\n\t<Redirect>?attempt=%[1]v&amp;node=main&amp;step=input</Redirect>", attempt)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		form     url.Values
+		expected string
+	}{
+		{name: "start", expected: menu(1)},
+		{name: "digits", query: "?node=main&attempt=1&step=input", form: url.Values{"Digits": {"1"}}, expected: `<Say voice="Alice">Connecting you to sales</Say>` + "\n\t<Dial>\n\t\t<Number>+13065551234</Number>\n\t</Dial>"},
+		{name: "speech", query: "?node=main&attempt=1&step=input", form: url.Values{"SpeechResult": {"I need Help."}}, expected: `<Say voice="Alice">Please hold</Say>` + "\n\t<Enqueue>support</Enqueue>"},
+		{name: "invalid digits", query: "?node=main&attempt=1&step=input", form: url.Values{"Digits": {"9"}}, expected: `<Say voice="Alice">Sorry, that is not a valid option.</Say>` + "\n\t" + menu(2)},
+		{name: "no input", query: "?node=main&attempt=1&step=input", expected: "<Response>\n\t" + menu(2)},
+		{name: "out of attempts", query: "?node=main&attempt=2&step=input", form: url.Values{"Digits": {"9"}}, expected: `<Say voice="Alice">Transferring you to an operator</Say>` + "\n\t<Redirect>/operator</Redirect>"},
+		{name: "node", query: "?node=support", expected: "<Enqueue>support</Enqueue>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/ivr"+tt.query, strings.NewReader(tt.form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "text/xml; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Body.String(), tt.expected)
+		})
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/ivr?node=missing", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestIVRHangsUpWithoutDefault(t *testing.T) {
+	h, err := NewIVRHandler(&IVR{Start: "main", Nodes: map[string]*IVRNode{
+		"main": {Say: "Press 1", Attempts: 1, Options: []*IVRChoice{{Digits: "1", Next: "bye"}}},
+		"bye":  {Say: "Goodbye"},
+	}})
+	assert.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/ivr?node=main&attempt=1&step=input", nil))
+	assert.Equal(t, xmlHeaderResponse("<Response></Response>"), w.Body.String())
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/ivr?node=main&attempt=1&step=input&Digits=1", nil))
+	assert.Equal(t, xmlHeaderResponse("<Response>\n\t<Say>Goodbye</Say>\n</Response>"), w.Body.String())
+}
+
+func xmlHeaderResponse(body string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + body
+}
+
+func TestIVRValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		ivr           string
+		expectedError string
+	}{
+		{name: "missing start", ivr: `{"start": "main", "nodes": {}}`, expectedError: "start node main is not defined"},
+		{name: "unknown field", ivr: `{"start": "main", "menus": {}}`, expectedError: `json: unknown field "menus"`},
+		{
+			name:          "undefined next",
+			ivr:           `{"start": "main", "nodes": {"main": {"say": "hi", "options": [{"digits": "1", "next": "sales"}]}}}`,
+			expectedError: "option 0 of node main moves to undefined node sales",
+		},
+		{
+			name:          "duplicate digits",
+			ivr:           `{"start": "main", "nodes": {"main": {"say": "hi", "options": [{"digits": "1", "next": "main"}, {"digits": "1", "next": "main"}]}}}`,
+			expectedError: "node main has more than one option for 1",
+		},
+		{
+			name:          "invalid digits",
+			ivr:           `{"start": "main", "nodes": {"main": {"say": "hi", "options": [{"digits": "a", "next": "main"}]}}}`,
+			expectedError: "option 0 of node main has invalid digits a",
+		},
+		{
+			name:          "empty option",
+			ivr:           `{"start": "main", "nodes": {"main": {"say": "hi", "options": [{"next": "main"}]}}}`,
+			expectedError: "option 0 of node main needs digits or phrases",
+		},
+		{
+			name:          "null option",
+			ivr:           `{"start": "main", "nodes": {"main": {"say": "hi", "options": [null]}}}`,
+			expectedError: "option 0 of node main is empty",
+		},
+		{
+			name:          "null node",
+			ivr:           `{"start": "main", "nodes": {"main": {"say": "hi"}, "other": null}}`,
+			expectedError: "node other is empty",
+		},
+		{
+			name:          "two actions",
+			ivr:           `{"start": "main", "nodes": {"main": {"say": "hi", "dial": "+13065551234", "redirect": "/x"}}}`,
+			expectedError: "node main can only dial, enqueue or redirect",
+		},
+		{
+			name:          "undefined default",
+			ivr:           `{"start": "main", "nodes": {"main": {"say": "hi", "options": [{"digits": "1", "next": "main"}], "default": "operator"}}}`,
+			expectedError: "node main defaults to undefined node operator",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseIVR([]byte(tt.ivr))
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestLoadIVR(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ivr")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "ivr.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(testIVR), 0644))
+	ivr, err := LoadIVR(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "main", ivr.Start)
+	assert.Len(t, ivr.Nodes, 4)
+}
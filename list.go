@@ -22,6 +22,17 @@ func (v *VTwilio) ListMessages(opts ...ListOption) (*List, error) {
 }
 
 func (v *VTwilio) listMessages(config *listOptionConfiguration) (*List, error) {
+	for _, n := range []*string{&config.To, &config.From} {
+		if *n == "" {
+			continue
+		}
+		normalized, err := v.normalizeNumber(*n)
+		if err != nil {
+			return nil, err
+		}
+		*n = normalized
+	}
+
 	urlStr := fmt.Sprintf("%s%s%s.json?PageSize=%v&Page=%v", v.baseAPI, v.accountSID, messageAPI, config.PageSize, config.Page)
 	values := buildListValues(config)
 	if values != "" {
@@ -0,0 +1,161 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// LookupField is a Lookup data package
+type LookupField string
+
+const (
+	// LookupLineTypeIntelligence returns the line type and carrier of a number
+	LookupLineTypeIntelligence LookupField = "line_type_intelligence"
+	// LookupCallerName returns the CNAM caller name of a US number
+	LookupCallerName LookupField = "caller_name"
+	// LookupSimSwap returns when the SIM of a mobile number was last changed
+	LookupSimSwap LookupField = "sim_swap"
+)
+
+// LineType is the type of line a number is on
+type LineType string
+
+const (
+	// Mobile line
+	Mobile LineType = "mobile"
+	// Landline line
+	Landline LineType = "landline"
+	// FixedVoIP line, a VoIP number tied to a physical address
+	FixedVoIP LineType = "fixedVoip"
+	// NonFixedVoIP line, a VoIP number that anyone can get online
+	NonFixedVoIP LineType = "nonFixedVoip"
+	// TollFree line
+	TollFree LineType = "tollFree"
+	// Personal line
+	Personal LineType = "personal"
+	// Pager line
+	Pager LineType = "pager"
+	// Voicemail line
+	Voicemail LineType = "voicemail"
+	// SharedCost line
+	SharedCost LineType = "sharedCost"
+	// Premium line
+	Premium LineType = "premium"
+	// UAN universal access number
+	UAN LineType = "uan"
+	// UnknownLineType could not be determined
+	UnknownLineType LineType = "unknown"
+)
+
+// IsVoIP reports whether the line is a fixed or non fixed VoIP line
+func (l LineType) IsVoIP() bool {
+	return l == FixedVoIP || l == NonFixedVoIP
+}
+
+// Lookup is a response from the Lookup API
+type Lookup struct {
+	CallingCountryCode   string                `json:"calling_country_code"`
+	CountryCode          string                `json:"country_code"`
+	PhoneNumber          string                `json:"phone_number"`
+	NationalFormat       string                `json:"national_format"`
+	Valid                bool                  `json:"valid"`
+	ValidationErrors     []string              `json:"validation_errors"`
+	CallerName           *CallerName           `json:"caller_name"`
+	LineTypeIntelligence *LineTypeIntelligence `json:"line_type_intelligence"`
+	SimSwap              *SimSwap              `json:"sim_swap"`
+	URL                  string                `json:"url"`
+}
+
+// CallerName is the caller name data package
+type CallerName struct {
+	CallerName string `json:"caller_name"`
+	CallerType string `json:"caller_type"`
+	ErrorCode  int    `json:"error_code"`
+}
+
+// LineTypeIntelligence is the line type intelligence data package
+type LineTypeIntelligence struct {
+	MobileCountryCode string   `json:"mobile_country_code"`
+	MobileNetworkCode string   `json:"mobile_network_code"`
+	CarrierName       string   `json:"carrier_name"`
+	Type              LineType `json:"type"`
+	ErrorCode         int      `json:"error_code"`
+}
+
+// SimSwap is the sim swap data package
+type SimSwap struct {
+	LastSimSwap       *LastSimSwap `json:"last_sim_swap"`
+	CarrierName       string       `json:"carrier_name"`
+	MobileCountryCode string       `json:"mobile_country_code"`
+	MobileNetworkCode string       `json:"mobile_network_code"`
+	ErrorCode         int          `json:"error_code"`
+}
+
+// LastSimSwap describes the most recent SIM change
+type LastSimSwap struct {
+	LastSimSwapDate string `json:"last_sim_swap_date"`
+	SwappedPeriod   string `json:"swapped_period"`
+	SwappedInPeriod bool   `json:"swapped_in_period"`
+}
+
+type lookupConfiguration struct {
+	Fields []LookupField
+}
+
+// LookupOption is an option for a lookup
+type LookupOption func(*lookupConfiguration)
+
+// LookupFields selects the data packages to fetch, each package is billed separately.
+// Without any fields only the free formatting and validation data is returned.
+func LookupFields(fields ...LookupField) LookupOption {
+	return func(c *lookupConfiguration) {
+		c.Fields = append(c.Fields, fields...)
+	}
+}
+
+// LookupPhoneNumber fetches information about a phone number from the Lookup API.
+// Responses are cached when the client has a LookupCacheTTL.
+func (v *VTwilio) LookupPhoneNumber(number string, opts ...LookupOption) (*Lookup, error) {
+	number, err := v.normalizeNumber(number)
+	if err != nil {
+		return nil, err
+	}
+	config := &lookupConfiguration{}
+	for _, o := range opts {
+		o(config)
+	}
+
+	fields := []string{}
+	seen := map[LookupField]bool{}
+	for _, f := range config.Fields {
+		if !seen[f] {
+			seen[f] = true
+			fields = append(fields, string(f))
+		}
+	}
+	sort.Strings(fields)
+
+	fetch := func() (*Lookup, error) {
+		return v.fetchLookup(number, fields)
+	}
+	if v.lookupCache != nil {
+		return v.lookupCache.get(number+"|"+strings.Join(fields, ","), fetch)
+	}
+	return fetch()
+}
+
+func (v *VTwilio) fetchLookup(number string, fields []string) (*Lookup, error) {
+	urlStr := fmt.Sprintf("%s%s", v.lookupAPI, url.PathEscape(number))
+	if len(fields) > 0 {
+		urlStr = fmt.Sprintf("%s?Fields=%s", urlStr, strings.Join(fields, ","))
+	}
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleLookup(req)
+}
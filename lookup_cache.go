@@ -0,0 +1,135 @@
+package vtwilio
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// lookupCache is an in memory cache of lookup responses that expire after a ttl. Concurrent
+// misses for the same key share a single request.
+type lookupCache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	now      func() time.Time
+	entries  map[string]lookupCacheEntry
+	expiry   []lookupCacheExpiry
+	inFlight map[string]*lookupCall
+}
+
+type lookupCacheEntry struct {
+	lookup  *Lookup
+	expires time.Time
+}
+
+// lookupCacheExpiry records when a key was set to expire, entries all share a ttl
+// so the expiry queue is already in expiry order
+type lookupCacheExpiry struct {
+	key     string
+	expires time.Time
+}
+
+type lookupCall struct {
+	done   chan struct{}
+	lookup *Lookup
+	err    error
+}
+
+func newLookupCache(ttl time.Duration) *lookupCache {
+	return &lookupCache{
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  map[string]lookupCacheEntry{},
+		inFlight: map[string]*lookupCall{},
+	}
+}
+
+// get returns a copy of the cached lookup for key, or fetches it. Callers that miss while a
+// fetch for key is running wait for that fetch instead of starting another.
+func (c *lookupCache) get(key string, fetch func() (*Lookup, error)) (*Lookup, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && c.now().Before(e.expires) {
+		c.mu.Unlock()
+		return copyLookup(e.lookup), nil
+	}
+	if call, ok := c.inFlight[key]; ok {
+		c.mu.Unlock()
+		<-call.done
+		if call.err != nil {
+			return nil, call.err
+		}
+		return copyLookup(call.lookup), nil
+	}
+	call := &lookupCall{done: make(chan struct{})}
+	c.inFlight[key] = call
+	c.mu.Unlock()
+
+	c.fetch(key, call, fetch)
+	if call.err != nil {
+		return nil, call.err
+	}
+	return copyLookup(call.lookup), nil
+}
+
+// fetch runs a call and hands its result to the callers waiting on it. If fetch panics the waiters
+// get an error, the key is free to be fetched again and the panic carries on in the fetching caller.
+func (c *lookupCache) fetch(key string, call *lookupCall, fetch func() (*Lookup, error)) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			call.lookup, call.err = nil, fmt.Errorf("lookup of %v panicked: %v", key, r)
+		}
+		c.mu.Lock()
+		delete(c.inFlight, key)
+		if call.err == nil {
+			c.set(key, call.lookup)
+		}
+		c.mu.Unlock()
+		close(call.done)
+		if r != nil {
+			panic(r)
+		}
+	}()
+	call.lookup, call.err = fetch()
+}
+
+// set stores a lookup and evicts the entries that have expired, c.mu must be held
+func (c *lookupCache) set(key string, l *Lookup) {
+	now := c.now()
+	for len(c.expiry) > 0 && !now.Before(c.expiry[0].expires) {
+		e := c.expiry[0]
+		c.expiry = c.expiry[1:]
+		if current, ok := c.entries[e.key]; ok && current.expires.Equal(e.expires) {
+			delete(c.entries, e.key)
+		}
+	}
+
+	expires := now.Add(c.ttl)
+	c.entries[key] = lookupCacheEntry{lookup: l, expires: expires}
+	c.expiry = append(c.expiry, lookupCacheExpiry{key: key, expires: expires})
+}
+
+// copyLookup returns a deep copy so callers cannot change a cached lookup
+func copyLookup(l *Lookup) *Lookup {
+	c := *l
+	if l.ValidationErrors != nil {
+		c.ValidationErrors = append([]string{}, l.ValidationErrors...)
+	}
+	if l.CallerName != nil {
+		cn := *l.CallerName
+		c.CallerName = &cn
+	}
+	if l.LineTypeIntelligence != nil {
+		lt := *l.LineTypeIntelligence
+		c.LineTypeIntelligence = &lt
+	}
+	if l.SimSwap != nil {
+		ss := *l.SimSwap
+		if l.SimSwap.LastSimSwap != nil {
+			last := *l.SimSwap.LastSimSwap
+			ss.LastSimSwap = &last
+		}
+		c.SimSwap = &ss
+	}
+	return &c
+}
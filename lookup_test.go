@@ -0,0 +1,253 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lookupResponse = `{
+	"calling_country_code": "1",
+	"country_code": "US",
+	"phone_number": "+14155551234",
+	"national_format": "(415) 555-1234",
+	"valid": true,
+	"validation_errors": [],
+	"caller_name": {"caller_name": "JOHN DOE", "caller_type": "CONSUMER", "error_code": null},
+	"line_type_intelligence": {"carrier_name": "Carrier", "mobile_country_code": "310", "mobile_network_code": "456", "type": "nonFixedVoip", "error_code": null},
+	"sim_swap": null,
+	"url": "https://lookups.twilio.com/v2/PhoneNumbers/+14155551234"
+}`
+
+func TestLookupPhoneNumber(t *testing.T) {
+	expected := &Lookup{
+		CallingCountryCode: "1",
+		CountryCode:        "US",
+		PhoneNumber:        "+14155551234",
+		NationalFormat:     "(415) 555-1234",
+		Valid:              true,
+		ValidationErrors:   []string{},
+		CallerName:         &CallerName{CallerName: "JOHN DOE", CallerType: "CONSUMER"},
+		LineTypeIntelligence: &LineTypeIntelligence{
+			CarrierName:       "Carrier",
+			MobileCountryCode: "310",
+			MobileNetworkCode: "456",
+			Type:              NonFixedVoIP,
+		},
+		URL: "https://lookups.twilio.com/v2/PhoneNumbers/+14155551234",
+	}
+
+	tests := []struct {
+		name          string
+		number        string
+		opts          []LookupOption
+		expectedPath  string
+		expectedQuery string
+		expected      *Lookup
+		expectedError error
+		failServer    bool
+	}{
+		{
+			name:         "no fields",
+			number:       "+14155551234",
+			expectedPath: "/+14155551234",
+			expected:     expected,
+		},
+		{
+			name:          "sorted and deduplicated fields",
+			number:        "+14155551234",
+			opts:          []LookupOption{LookupFields(LookupLineTypeIntelligence, LookupCallerName, LookupLineTypeIntelligence)},
+			expectedPath:  "/+14155551234",
+			expectedQuery: "Fields=caller_name,line_type_intelligence",
+			expected:      expected,
+		},
+		{
+			name:          "invalid number",
+			number:        "4155551234",
+			expectedError: fmt.Errorf("phone number must begin with + or a default region must be set"),
+		},
+		{
+			name:          "bad response",
+			number:        "+14155551234",
+			expectedPath:  "/+14155551234",
+			failServer:    true,
+			expectedError: fmt.Errorf("Error: not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				assert.Equal(t, tt.expectedQuery, r.URL.RawQuery)
+				if tt.failServer {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(`{"message": "not found"}`))
+					return
+				}
+				w.Write([]byte(lookupResponse))
+			}))
+			defer ts.Close()
+
+			v := NewVTwilio("sid", "token")
+			v.lookupAPI = fmt.Sprintf("%s/", ts.URL)
+
+			actual, err := v.LookupPhoneNumber(tt.number, tt.opts...)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestLookupCache(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(lookupResponse))
+	}))
+	defer ts.Close()
+
+	now := time.Date(2017, time.August, 31, 1, 0, 0, 0, time.UTC)
+	v := NewVTwilio("sid", "token", LookupCacheTTL(time.Hour))
+	v.lookupAPI = fmt.Sprintf("%s/", ts.URL)
+	v.lookupCache.now = func() time.Time { return now }
+
+	fields := LookupFields(LookupLineTypeIntelligence)
+	_, err := v.LookupPhoneNumber("+14155551234", fields)
+	assert.NoError(t, err)
+	_, err = v.LookupPhoneNumber("+1 (415) 555-1234", fields)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests, "same number and fields should be cached")
+
+	_, err = v.LookupPhoneNumber("+14155551234", LookupFields(LookupCallerName))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests, "different fields should not be cached")
+
+	now = now.Add(time.Hour)
+	_, err = v.LookupPhoneNumber("+14155551234", fields)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, requests, "expired entries should be fetched again")
+}
+
+func TestLookupCacheCopies(t *testing.T) {
+	c := newLookupCache(time.Hour)
+	fetch := func() (*Lookup, error) {
+		return &Lookup{PhoneNumber: "+14155551234", CallerName: &CallerName{CallerName: "Sergio"}}, nil
+	}
+
+	first, err := c.get("key", fetch)
+	assert.NoError(t, err)
+	first.PhoneNumber = "changed"
+	first.CallerName.CallerName = "changed"
+
+	second, err := c.get("key", fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, &Lookup{PhoneNumber: "+14155551234", CallerName: &CallerName{CallerName: "Sergio"}}, second)
+}
+
+func TestLookupCacheSharesRequests(t *testing.T) {
+	c := newLookupCache(time.Hour)
+	var fetches int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func() (*Lookup, error) {
+		if atomic.AddInt32(&fetches, 1) == 1 {
+			close(started)
+			<-release
+		}
+		return &Lookup{PhoneNumber: "+14155551234"}, nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		c.get("key", fetch)
+	}()
+	<-started
+	for i := 0; i < 4; i++ {
+		go func() {
+			defer wg.Done()
+			l, err := c.get("key", fetch)
+			assert.NoError(t, err)
+			assert.Equal(t, "+14155551234", l.PhoneNumber)
+		}()
+	}
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
+
+func TestLookupCacheErrorsAreNotCached(t *testing.T) {
+	c := newLookupCache(time.Hour)
+	_, err := c.get("key", func() (*Lookup, error) { return nil, fmt.Errorf("Error: not found") })
+	assert.Equal(t, fmt.Errorf("Error: not found"), err)
+
+	l, err := c.get("key", func() (*Lookup, error) { return &Lookup{Valid: true}, nil })
+	assert.NoError(t, err)
+	assert.True(t, l.Valid)
+}
+
+func TestLookupCachePanicReleasesWaiters(t *testing.T) {
+	c := newLookupCache(time.Hour)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go func() {
+		defer func() {
+			assert.Equal(t, "boom", recover())
+		}()
+		c.get("key", func() (*Lookup, error) {
+			close(started)
+			<-release
+			panic("boom")
+		})
+	}()
+	<-started
+
+	waited := make(chan error)
+	go func() {
+		_, err := c.get("key", func() (*Lookup, error) { return &Lookup{}, nil })
+		waited <- err
+	}()
+	// give the waiter time to join the running fetch
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	select {
+	case err := <-waited:
+		assert.Equal(t, fmt.Errorf("lookup of key panicked: boom"), err)
+	case <-time.After(time.Second):
+		t.Fatal("waiter was not released")
+	}
+
+	l, err := c.get("key", func() (*Lookup, error) { return &Lookup{Valid: true}, nil })
+	assert.NoError(t, err)
+	assert.True(t, l.Valid)
+}
+
+func TestLookupCacheEvictsExpired(t *testing.T) {
+	now := time.Date(2017, time.August, 31, 1, 0, 0, 0, time.UTC)
+	c := newLookupCache(time.Hour)
+	c.now = func() time.Time { return now }
+	fetch := func() (*Lookup, error) { return &Lookup{}, nil }
+
+	c.get("a", fetch)
+	now = now.Add(30 * time.Minute)
+	c.get("b", fetch)
+	now = now.Add(45 * time.Minute)
+	c.get("c", fetch)
+	assert.Len(t, c.entries, 2)
+	assert.Len(t, c.expiry, 2)
+}
+
+func TestLineTypeIsVoIP(t *testing.T) {
+	assert.True(t, FixedVoIP.IsVoIP())
+	assert.True(t, NonFixedVoIP.IsVoIP())
+	assert.False(t, Mobile.IsVoIP())
+	assert.False(t, Landline.IsVoIP())
+}
@@ -0,0 +1,245 @@
+package vtwilio
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/twiebe-va/vtwilio-go/phonenumber"
+	"github.com/twiebe-va/vtwilio-go/twiml"
+)
+
+// MessageHandler replies to an incoming message, a nil TwiML sends no reply
+type MessageHandler func(ctx context.Context, m *IncomingMessage) (*twiml.TwiML, error)
+
+// RouteMatcher reports whether a route should handle a message
+type RouteMatcher func(m *IncomingMessage) bool
+
+// MessageRouter is an http.Handler for SMS webhooks that passes each message to the first route that matches it
+// and writes the TwiML the route returns
+type MessageRouter struct {
+	routes   []messageRoute
+	notFound MessageHandler
+	fallback *twiml.TwiML
+	onError  func(*IncomingMessage, error)
+	allowed  map[string]bool
+	denied   map[string]bool
+}
+
+type messageRoute struct {
+	matchers []RouteMatcher
+	handler  MessageHandler
+}
+
+// MessageRouterOption is an option for a message router
+type MessageRouterOption func(*MessageRouter)
+
+// RouterNotFound handles messages that match no route, by default they get no reply
+func RouterNotFound(h MessageHandler) MessageRouterOption {
+	return func(r *MessageRouter) {
+		r.notFound = h
+	}
+}
+
+// RouterFallback is the reply sent when a handler fails, panics or returns TwiML that does not build,
+// defaults to no reply
+func RouterFallback(t *twiml.TwiML) MessageRouterOption {
+	return func(r *MessageRouter) {
+		r.fallback = t
+	}
+}
+
+// RouterOnError is called with the errors returned by handlers, including panics and replies that do not build
+func RouterOnError(f func(*IncomingMessage, error)) MessageRouterOption {
+	return func(r *MessageRouter) {
+		r.onError = f
+	}
+}
+
+// RouterAllowSenders only routes messages from these numbers, messages from other numbers get no reply.
+// Without numbers it allows every sender.
+func RouterAllowSenders(numbers ...string) MessageRouterOption {
+	return func(r *MessageRouter) {
+		if r.allowed == nil && len(numbers) > 0 {
+			r.allowed = map[string]bool{}
+		}
+		for _, n := range numbers {
+			r.allowed[canonicalNumber(n)] = true
+		}
+	}
+}
+
+// RouterDenySenders drops messages from these numbers without a reply
+func RouterDenySenders(numbers ...string) MessageRouterOption {
+	return func(r *MessageRouter) {
+		for _, n := range numbers {
+			r.denied[canonicalNumber(n)] = true
+		}
+	}
+}
+
+// NewMessageRouter returns a router without routes
+func NewMessageRouter(opts ...MessageRouterOption) *MessageRouter {
+	r := &MessageRouter{denied: map[string]bool{}}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// Handle adds a route for messages that match every matcher, routes are tried in the order they are added
+func (r *MessageRouter) Handle(h MessageHandler, matchers ...RouteMatcher) {
+	r.routes = append(r.routes, messageRoute{matchers: matchers, handler: h})
+}
+
+// ToNumber matches messages sent to one of numbers
+func ToNumber(numbers ...string) RouteMatcher {
+	set := map[string]bool{}
+	for _, n := range numbers {
+		set[canonicalNumber(n)] = true
+	}
+	return func(m *IncomingMessage) bool {
+		return set[canonicalNumber(m.To)]
+	}
+}
+
+// FromSender matches messages sent from one of numbers
+func FromSender(numbers ...string) RouteMatcher {
+	set := map[string]bool{}
+	for _, n := range numbers {
+		set[canonicalNumber(n)] = true
+	}
+	return func(m *IncomingMessage) bool {
+		return set[canonicalNumber(m.From)]
+	}
+}
+
+// NotFromSender matches messages that were not sent from any of numbers
+func NotFromSender(numbers ...string) RouteMatcher {
+	from := FromSender(numbers...)
+	return func(m *IncomingMessage) bool {
+		return !from(m)
+	}
+}
+
+// Keyword matches messages whose first word is one of keywords, ignoring case
+func Keyword(keywords ...string) RouteMatcher {
+	return func(m *IncomingMessage) bool {
+		fields := strings.Fields(m.Body)
+		if len(fields) == 0 {
+			return false
+		}
+		for _, k := range keywords {
+			if strings.EqualFold(fields[0], k) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// BodyMatches matches messages whose body matches re
+func BodyMatches(re *regexp.Regexp) RouteMatcher {
+	return func(m *IncomingMessage) bool {
+		return re.MatchString(m.Body)
+	}
+}
+
+// ServeHTTP parses an SMS webhook request and writes the reply of the matching route
+func (r *MessageRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	m, err := ParseIncomingMessage(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	from := canonicalNumber(m.From)
+	if r.denied[from] || (r.allowed != nil && !r.allowed[from]) {
+		writeTwiML(w, nil)
+		return
+	}
+
+	h := r.match(m)
+	if h == nil {
+		writeTwiML(w, nil)
+		return
+	}
+	reply, err := r.call(req.Context(), h, m)
+	if err == nil {
+		var body []byte
+		if body, err = buildTwiML(reply); err == nil {
+			writeTwiMLBody(w, body)
+			return
+		}
+		err = fmt.Errorf("invalid reply: %v", err)
+	}
+	if r.onError != nil {
+		r.onError(m, err)
+	}
+	writeTwiML(w, r.fallback)
+}
+
+// call runs a handler, returning a panic as an error
+func (r *MessageRouter) call(ctx context.Context, h MessageHandler, m *IncomingMessage) (reply *twiml.TwiML, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			if p == http.ErrAbortHandler {
+				panic(p)
+			}
+			reply, err = nil, fmt.Errorf("handler panicked: %v", p)
+		}
+	}()
+	return h(ctx, m)
+}
+
+func (r *MessageRouter) match(m *IncomingMessage) MessageHandler {
+	for _, route := range r.routes {
+		matched := true
+		for _, match := range route.matchers {
+			if !match(m) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return route.handler
+		}
+	}
+	return r.notFound
+}
+
+// writeTwiML writes t as a text/xml response, an empty <Response> is written for a nil t or one that does not build
+func writeTwiML(w http.ResponseWriter, t *twiml.TwiML) {
+	body, err := buildTwiML(t)
+	if err != nil {
+		body, _ = buildTwiML(nil)
+	}
+	writeTwiMLBody(w, body)
+}
+
+// buildTwiML builds t, a nil t builds an empty <Response>
+func buildTwiML(t *twiml.TwiML) ([]byte, error) {
+	if t == nil {
+		t = twiml.NewTwiML()
+	}
+	return t.Build()
+}
+
+// writeTwiMLBody writes built TwiML as a text/xml response
+func writeTwiMLBody(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+}
+
+// canonicalNumber returns a number in E.164 format when it can be parsed
+func canonicalNumber(n string) string {
+	if e164, err := phonenumber.Normalize(n, ""); err == nil {
+		return e164
+	}
+	return n
+}
@@ -0,0 +1,111 @@
+package vtwilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twiebe-va/vtwilio-go/twiml"
+)
+
+func reply(text string) MessageHandler {
+	return func(ctx context.Context, m *IncomingMessage) (*twiml.TwiML, error) {
+		return twiml.NewTwiML().Message(text), nil
+	}
+}
+
+func TestMessageRouter(t *testing.T) {
+	var handlerErrors []error
+	router := NewMessageRouter(
+		RouterDenySenders("+13065550000"),
+		RouterNotFound(reply("unknown command")),
+		RouterFallback(twiml.NewTwiML().Message("something went wrong")),
+		RouterOnError(func(m *IncomingMessage, err error) {
+			handlerErrors = append(handlerErrors, err)
+		}),
+	)
+	router.Handle(reply("unsubscribed"), Keyword("STOP", "unsubscribe"))
+	router.Handle(reply("support"), ToNumber("+1 306 555 9999"))
+	router.Handle(reply("order"), BodyMatches(regexp.MustCompile(`^#\d+$`)), NotFromSender("+13065551111"))
+	router.Handle(func(ctx context.Context, m *IncomingMessage) (*twiml.TwiML, error) {
+		return nil, fmt.Errorf("boom")
+	}, Keyword("fail"))
+	router.Handle(func(ctx context.Context, m *IncomingMessage) (*twiml.TwiML, error) {
+		return nil, nil
+	}, Keyword("quiet"))
+	router.Handle(func(ctx context.Context, m *IncomingMessage) (*twiml.TwiML, error) {
+		panic("oops")
+	}, Keyword("panic"))
+
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		body     string
+		expected string
+	}{
+		{name: "keyword", body: "stop please", expected: "<Message>unsubscribed</Message>"},
+		{name: "destination", to: "+13065559999", body: "help", expected: "<Message>support</Message>"},
+		{name: "regex", body: "#123", expected: "<Message>order</Message>"},
+		{name: "regex from excluded sender", from: "+13065551111", body: "#123", expected: "<Message>unknown command</Message>"},
+		{name: "not found", body: "hello", expected: "<Message>unknown command</Message>"},
+		{name: "denied sender", from: "+13065550000", body: "stop", expected: "<Response></Response>"},
+		{name: "handler error", body: "fail", expected: "<Message>something went wrong</Message>"},
+		{name: "no reply", body: "quiet", expected: "<Response></Response>"},
+		{name: "handler panic", body: "panic", expected: "<Message>something went wrong</Message>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.from == "" {
+				tt.from = "+13065551234"
+			}
+			if tt.to == "" {
+				tt.to = "+13065554321"
+			}
+			params := url.Values{"MessageSid": {"SM123"}, "From": {tt.from}, "To": {tt.to}, "Body": {tt.body}}
+			r := httptest.NewRequest("POST", "/sms", strings.NewReader(params.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "text/xml; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.True(t, strings.HasPrefix(w.Body.String(), "<?xml"))
+			assert.Contains(t, w.Body.String(), tt.expected)
+		})
+	}
+	assert.Equal(t, []error{fmt.Errorf("boom"), fmt.Errorf("handler panicked: oops")}, handlerErrors)
+}
+
+func TestMessageRouterAllowSenders(t *testing.T) {
+	everyone := NewMessageRouter(RouterAllowSenders())
+	everyone.Handle(reply("hi"))
+	params := url.Values{"MessageSid": {"SM123"}, "From": {"+13065554321"}, "To": {"+13065559999"}}
+	w := httptest.NewRecorder()
+	everyone.ServeHTTP(w, httptest.NewRequest("GET", "/sms?"+params.Encode(), nil))
+	assert.Contains(t, w.Body.String(), "<Message>hi</Message>", "no numbers allows every sender")
+
+	router := NewMessageRouter(RouterAllowSenders("+13065551234"))
+	router.Handle(reply("hi"))
+
+	for from, expected := range map[string]string{
+		"+13065551234": "<Message>hi</Message>",
+		"+13065554321": "<Response></Response>",
+	} {
+		params := url.Values{"MessageSid": {"SM123"}, "From": {from}, "To": {"+13065559999"}}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/sms?"+params.Encode(), nil))
+		assert.Contains(t, w.Body.String(), expected)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/sms", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -1,6 +1,9 @@
 // Code generated by mockery v1.0.0
 package mocks
 
+import context "context"
+import io "io"
+import time "time"
 import mock "github.com/stretchr/testify/mock"
 import vtwilio "github.com/twiebe-va/vtwilio-go"
 
@@ -9,6 +12,112 @@ type Interface struct {
 	mock.Mock
 }
 
+// AddParticipant provides a mock function with given fields: conferenceSID, to, from, opts
+func (_m *Interface) AddParticipant(conferenceSID string, to string, from string, opts ...vtwilio.ParticipantOption) (*vtwilio.Participant, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, conferenceSID, to, from)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.Participant
+	if rf, ok := ret.Get(0).(func(string, string, string, ...vtwilio.ParticipantOption) *vtwilio.Participant); ok {
+		r0 = rf(conferenceSID, to, from, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Participant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, ...vtwilio.ParticipantOption) error); ok {
+		r1 = rf(conferenceSID, to, from, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyNumbersPlan provides a mock function with given fields: plan, opts
+func (_m *Interface) ApplyNumbersPlan(plan *vtwilio.NumbersPlan, opts ...vtwilio.ApplyOption) (*vtwilio.ApplyResult, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, plan)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.ApplyResult
+	if rf, ok := ret.Get(0).(func(*vtwilio.NumbersPlan, ...vtwilio.ApplyOption) *vtwilio.ApplyResult); ok {
+		r0 = rf(plan, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.ApplyResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*vtwilio.NumbersPlan, ...vtwilio.ApplyOption) error); ok {
+		r1 = rf(plan, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ArchiveRecordings provides a mock function with given fields: dir, cutoff, format
+func (_m *Interface) ArchiveRecordings(dir string, cutoff time.Time, format vtwilio.RecordingFormat) (*vtwilio.RecordingArchive, error) {
+	ret := _m.Called(dir, cutoff, format)
+
+	var r0 *vtwilio.RecordingArchive
+	if rf, ok := ret.Get(0).(func(string, time.Time, vtwilio.RecordingFormat) *vtwilio.RecordingArchive); ok {
+		r0 = rf(dir, cutoff, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.RecordingArchive)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time, vtwilio.RecordingFormat) error); ok {
+		r1 = rf(dir, cutoff, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AvailableCountries provides a mock function with given fields: 
+func (_m *Interface) AvailableCountries() (*vtwilio.AvailableCountries, error) {
+	ret := _m.Called()
+
+	var r0 *vtwilio.AvailableCountries
+	if rf, ok := ret.Get(0).(func() *vtwilio.AvailableCountries); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.AvailableCountries)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AvailablePhoneNumbers provides a mock function with given fields: countryCode, opts
 func (_m *Interface) AvailablePhoneNumbers(countryCode string, opts ...vtwilio.AvailableOption) (*vtwilio.AvailablePhoneNumbers, error) {
 	_va := make([]interface{}, len(opts))
@@ -16,22 +125,762 @@ func (_m *Interface) AvailablePhoneNumbers(countryCode string, opts ...vtwilio.A
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, countryCode)
+	_ca = append(_ca, countryCode)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.AvailablePhoneNumbers
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.AvailableOption) *vtwilio.AvailablePhoneNumbers); ok {
+		r0 = rf(countryCode, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.AvailablePhoneNumbers)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.AvailableOption) error); ok {
+		r1 = rf(countryCode, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelCall provides a mock function with given fields: sid
+func (_m *Interface) CancelCall(sid string) (*vtwilio.Call, error) {
+	ret := _m.Called(sid)
+
+	var r0 *vtwilio.Call
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.Call); ok {
+		r0 = rf(sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Call)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckWebhooks provides a mock function with given fields: opts
+func (_m *Interface) CheckWebhooks(opts ...vtwilio.WebhookCheckOption) (*vtwilio.WebhookReport, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.WebhookReport
+	if rf, ok := ret.Get(0).(func(...vtwilio.WebhookCheckOption) *vtwilio.WebhookReport); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.WebhookReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...vtwilio.WebhookCheckOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CoachParticipant provides a mock function with given fields: conferenceSID, callSID, callSIDToCoach
+func (_m *Interface) CoachParticipant(conferenceSID string, callSID string, callSIDToCoach string) (*vtwilio.Participant, error) {
+	ret := _m.Called(conferenceSID, callSID, callSIDToCoach)
+
+	var r0 *vtwilio.Participant
+	if rf, ok := ret.Get(0).(func(string, string, string) *vtwilio.Participant); ok {
+		r0 = rf(conferenceSID, callSID, callSIDToCoach)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Participant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(conferenceSID, callSID, callSIDToCoach)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateQueue provides a mock function with given fields: friendlyName, opts
+func (_m *Interface) CreateQueue(friendlyName string, opts ...vtwilio.QueueOption) (*vtwilio.Queue, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, friendlyName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.Queue
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.QueueOption) *vtwilio.Queue); ok {
+		r0 = rf(friendlyName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Queue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.QueueOption) error); ok {
+		r1 = rf(friendlyName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteQueue provides a mock function with given fields: sid
+func (_m *Interface) DeleteQueue(sid string) error {
+	ret := _m.Called(sid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(sid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRecording provides a mock function with given fields: sid
+func (_m *Interface) DeleteRecording(sid string) error {
+	ret := _m.Called(sid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(sid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DequeueFront provides a mock function with given fields: queueSID, redirectURL, method
+func (_m *Interface) DequeueFront(queueSID string, redirectURL string, method vtwilio.Method) (*vtwilio.QueueMember, error) {
+	ret := _m.Called(queueSID, redirectURL, method)
+
+	var r0 *vtwilio.QueueMember
+	if rf, ok := ret.Get(0).(func(string, string, vtwilio.Method) *vtwilio.QueueMember); ok {
+		r0 = rf(queueSID, redirectURL, method)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.QueueMember)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, vtwilio.Method) error); ok {
+		r1 = rf(queueSID, redirectURL, method)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DequeueMember provides a mock function with given fields: queueSID, callSID, redirectURL, method
+func (_m *Interface) DequeueMember(queueSID string, callSID string, redirectURL string, method vtwilio.Method) (*vtwilio.QueueMember, error) {
+	ret := _m.Called(queueSID, callSID, redirectURL, method)
+
+	var r0 *vtwilio.QueueMember
+	if rf, ok := ret.Get(0).(func(string, string, string, vtwilio.Method) *vtwilio.QueueMember); ok {
+		r0 = rf(queueSID, callSID, redirectURL, method)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.QueueMember)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, vtwilio.Method) error); ok {
+		r1 = rf(queueSID, callSID, redirectURL, method)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DownloadRecording provides a mock function with given fields: sid, format, w
+func (_m *Interface) DownloadRecording(sid string, format vtwilio.RecordingFormat, w io.Writer) error {
+	ret := _m.Called(sid, format, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, vtwilio.RecordingFormat, io.Writer) error); ok {
+		r0 = rf(sid, format, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EndCall provides a mock function with given fields: sid
+func (_m *Interface) EndCall(sid string) (*vtwilio.Call, error) {
+	ret := _m.Called(sid)
+
+	var r0 *vtwilio.Call
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.Call); ok {
+		r0 = rf(sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Call)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EndConference provides a mock function with given fields: sid
+func (_m *Interface) EndConference(sid string) (*vtwilio.Conference, error) {
+	ret := _m.Called(sid)
+
+	var r0 *vtwilio.Conference
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.Conference); ok {
+		r0 = rf(sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Conference)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindIncomingPhoneNumber provides a mock function with given fields: number
+func (_m *Interface) FindIncomingPhoneNumber(number string) (*vtwilio.IncomingPhoneNumber, error) {
+	ret := _m.Called(number)
+
+	var r0 *vtwilio.IncomingPhoneNumber
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.IncomingPhoneNumber); ok {
+		r0 = rf(number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.IncomingPhoneNumber)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCall provides a mock function with given fields: sid
+func (_m *Interface) GetCall(sid string) (*vtwilio.Call, error) {
+	ret := _m.Called(sid)
+
+	var r0 *vtwilio.Call
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.Call); ok {
+		r0 = rf(sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Call)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConference provides a mock function with given fields: sid
+func (_m *Interface) GetConference(sid string) (*vtwilio.Conference, error) {
+	ret := _m.Called(sid)
+
+	var r0 *vtwilio.Conference
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.Conference); ok {
+		r0 = rf(sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Conference)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIncomingPhoneNumber provides a mock function with given fields: sid
+func (_m *Interface) GetIncomingPhoneNumber(sid string) (*vtwilio.IncomingPhoneNumber, error) {
+	ret := _m.Called(sid)
+
+	var r0 *vtwilio.IncomingPhoneNumber
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.IncomingPhoneNumber); ok {
+		r0 = rf(sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.IncomingPhoneNumber)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMessage provides a mock function with given fields: messageSID
+func (_m *Interface) GetMessage(messageSID string) (*vtwilio.Message, error) {
+	ret := _m.Called(messageSID)
+
+	var r0 *vtwilio.Message
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.Message); ok {
+		r0 = rf(messageSID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Message)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(messageSID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQueue provides a mock function with given fields: sid
+func (_m *Interface) GetQueue(sid string) (*vtwilio.Queue, error) {
+	ret := _m.Called(sid)
+
+	var r0 *vtwilio.Queue
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.Queue); ok {
+		r0 = rf(sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Queue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQueueMember provides a mock function with given fields: queueSID, callSID
+func (_m *Interface) GetQueueMember(queueSID string, callSID string) (*vtwilio.QueueMember, error) {
+	ret := _m.Called(queueSID, callSID)
+
+	var r0 *vtwilio.QueueMember
+	if rf, ok := ret.Get(0).(func(string, string) *vtwilio.QueueMember); ok {
+		r0 = rf(queueSID, callSID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.QueueMember)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(queueSID, callSID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRecording provides a mock function with given fields: sid
+func (_m *Interface) GetRecording(sid string) (*vtwilio.Recording, error) {
+	ret := _m.Called(sid)
+
+	var r0 *vtwilio.Recording
+	if rf, ok := ret.Get(0).(func(string) *vtwilio.Recording); ok {
+		r0 = rf(sid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Recording)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HoldParticipant provides a mock function with given fields: conferenceSID, callSID, hold, holdURL
+func (_m *Interface) HoldParticipant(conferenceSID string, callSID string, hold bool, holdURL string) (*vtwilio.Participant, error) {
+	ret := _m.Called(conferenceSID, callSID, hold, holdURL)
+
+	var r0 *vtwilio.Participant
+	if rf, ok := ret.Get(0).(func(string, string, bool, string) *vtwilio.Participant); ok {
+		r0 = rf(conferenceSID, callSID, hold, holdURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Participant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, bool, string) error); ok {
+		r1 = rf(conferenceSID, callSID, hold, holdURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncomingPhoneNumber provides a mock function with given fields: number, opts
+func (_m *Interface) IncomingPhoneNumber(number string, opts ...vtwilio.IncomingPhoneNumberOption) (*vtwilio.IncomingPhoneNumber, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, number)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.IncomingPhoneNumber
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.IncomingPhoneNumberOption) *vtwilio.IncomingPhoneNumber); ok {
+		r0 = rf(number, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.IncomingPhoneNumber)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.IncomingPhoneNumberOption) error); ok {
+		r1 = rf(number, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCalls provides a mock function with given fields: opts
+func (_m *Interface) ListCalls(opts ...vtwilio.CallListOption) (*vtwilio.CallList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.CallList
+	if rf, ok := ret.Get(0).(func(...vtwilio.CallListOption) *vtwilio.CallList); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.CallList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...vtwilio.CallListOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListConferences provides a mock function with given fields: opts
+func (_m *Interface) ListConferences(opts ...vtwilio.ConferenceListOption) (*vtwilio.ConferenceList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.ConferenceList
+	if rf, ok := ret.Get(0).(func(...vtwilio.ConferenceListOption) *vtwilio.ConferenceList); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.ConferenceList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...vtwilio.ConferenceListOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListIncomingPhoneNumbers provides a mock function with given fields: opts
+func (_m *Interface) ListIncomingPhoneNumbers(opts ...vtwilio.IncomingPhoneNumberListOption) (*vtwilio.IncomingPhoneNumberList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.IncomingPhoneNumberList
+	if rf, ok := ret.Get(0).(func(...vtwilio.IncomingPhoneNumberListOption) *vtwilio.IncomingPhoneNumberList); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.IncomingPhoneNumberList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...vtwilio.IncomingPhoneNumberListOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMessages provides a mock function with given fields: opts
+func (_m *Interface) ListMessages(opts ...vtwilio.ListOption) (*vtwilio.List, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.List
+	if rf, ok := ret.Get(0).(func(...vtwilio.ListOption) *vtwilio.List); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.List)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...vtwilio.ListOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListParticipants provides a mock function with given fields: conferenceSID, opts
+func (_m *Interface) ListParticipants(conferenceSID string, opts ...vtwilio.ParticipantListOption) (*vtwilio.ParticipantList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, conferenceSID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.ParticipantList
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.ParticipantListOption) *vtwilio.ParticipantList); ok {
+		r0 = rf(conferenceSID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.ParticipantList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.ParticipantListOption) error); ok {
+		r1 = rf(conferenceSID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListQueueMembers provides a mock function with given fields: queueSID, opts
+func (_m *Interface) ListQueueMembers(queueSID string, opts ...vtwilio.QueueListOption) (*vtwilio.QueueMemberList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, queueSID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.QueueMemberList
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.QueueListOption) *vtwilio.QueueMemberList); ok {
+		r0 = rf(queueSID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.QueueMemberList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.QueueListOption) error); ok {
+		r1 = rf(queueSID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListQueues provides a mock function with given fields: opts
+func (_m *Interface) ListQueues(opts ...vtwilio.QueueListOption) (*vtwilio.QueueList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.QueueList
+	if rf, ok := ret.Get(0).(func(...vtwilio.QueueListOption) *vtwilio.QueueList); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.QueueList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...vtwilio.QueueListOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRecordings provides a mock function with given fields: opts
+func (_m *Interface) ListRecordings(opts ...vtwilio.RecordingListOption) (*vtwilio.RecordingList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.RecordingList
+	if rf, ok := ret.Get(0).(func(...vtwilio.RecordingListOption) *vtwilio.RecordingList); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.RecordingList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...vtwilio.RecordingListOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupPhoneNumber provides a mock function with given fields: number, opts
+func (_m *Interface) LookupPhoneNumber(number string, opts ...vtwilio.LookupOption) (*vtwilio.Lookup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, number)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *vtwilio.AvailablePhoneNumbers
-	if rf, ok := ret.Get(0).(func(string, ...vtwilio.AvailableOption) *vtwilio.AvailablePhoneNumbers); ok {
-		r0 = rf(countryCode, opts...)
+	var r0 *vtwilio.Lookup
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.LookupOption) *vtwilio.Lookup); ok {
+		r0 = rf(number, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*vtwilio.AvailablePhoneNumbers)
+			r0 = ret.Get(0).(*vtwilio.Lookup)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, ...vtwilio.AvailableOption) error); ok {
-		r1 = rf(countryCode, opts...)
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.LookupOption) error); ok {
+		r1 = rf(number, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -39,22 +888,29 @@ func (_m *Interface) AvailablePhoneNumbers(countryCode string, opts ...vtwilio.A
 	return r0, r1
 }
 
-// GetMessage provides a mock function with given fields: messageSID
-func (_m *Interface) GetMessage(messageSID string) (*vtwilio.Message, error) {
-	ret := _m.Called(messageSID)
+// MakeCall provides a mock function with given fields: to, from, opts
+func (_m *Interface) MakeCall(to string, from string, opts ...vtwilio.CallOption) (*vtwilio.Call, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, to, from)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r0 *vtwilio.Message
-	if rf, ok := ret.Get(0).(func(string) *vtwilio.Message); ok {
-		r0 = rf(messageSID)
+	var r0 *vtwilio.Call
+	if rf, ok := ret.Get(0).(func(string, string, ...vtwilio.CallOption) *vtwilio.Call); ok {
+		r0 = rf(to, from, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*vtwilio.Message)
+			r0 = ret.Get(0).(*vtwilio.Call)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(messageSID)
+	if rf, ok := ret.Get(1).(func(string, string, ...vtwilio.CallOption) error); ok {
+		r1 = rf(to, from, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,20 +918,126 @@ func (_m *Interface) GetMessage(messageSID string) (*vtwilio.Message, error) {
 	return r0, r1
 }
 
-// IncomingPhoneNumber provides a mock function with given fields: number, opts
-func (_m *Interface) IncomingPhoneNumber(number string, opts ...vtwilio.IncomingPhoneNumberOption) (*vtwilio.IncomingPhoneNumber, error) {
+// MuteParticipant provides a mock function with given fields: conferenceSID, callSID, muted
+func (_m *Interface) MuteParticipant(conferenceSID string, callSID string, muted bool) (*vtwilio.Participant, error) {
+	ret := _m.Called(conferenceSID, callSID, muted)
+
+	var r0 *vtwilio.Participant
+	if rf, ok := ret.Get(0).(func(string, string, bool) *vtwilio.Participant); ok {
+		r0 = rf(conferenceSID, callSID, muted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Participant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(conferenceSID, callSID, muted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseCallRecording provides a mock function with given fields: callSID, recordingSID, opts
+func (_m *Interface) PauseCallRecording(callSID string, recordingSID string, opts ...vtwilio.PauseOption) (*vtwilio.Recording, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, number)
+	_ca = append(_ca, callSID, recordingSID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.Recording
+	if rf, ok := ret.Get(0).(func(string, string, ...vtwilio.PauseOption) *vtwilio.Recording); ok {
+		r0 = rf(callSID, recordingSID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Recording)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, ...vtwilio.PauseOption) error); ok {
+		r1 = rf(callSID, recordingSID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlanNumbers provides a mock function with given fields: state
+func (_m *Interface) PlanNumbers(state *vtwilio.NumberState) (*vtwilio.NumbersPlan, error) {
+	ret := _m.Called(state)
+
+	var r0 *vtwilio.NumbersPlan
+	if rf, ok := ret.Get(0).(func(*vtwilio.NumberState) *vtwilio.NumbersPlan); ok {
+		r0 = rf(state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.NumbersPlan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*vtwilio.NumberState) error); ok {
+		r1 = rf(state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProvisionNumber provides a mock function with given fields: ctx, criteria, config
+func (_m *Interface) ProvisionNumber(ctx context.Context, criteria vtwilio.NumberCriteria, config ...vtwilio.IncomingPhoneNumberOption) (*vtwilio.ProvisionReport, error) {
+	_va := make([]interface{}, len(config))
+	for _i := range config {
+		_va[_i] = config[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, criteria)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.ProvisionReport
+	if rf, ok := ret.Get(0).(func(context.Context, vtwilio.NumberCriteria, ...vtwilio.IncomingPhoneNumberOption) *vtwilio.ProvisionReport); ok {
+		r0 = rf(ctx, criteria, config...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.ProvisionReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, vtwilio.NumberCriteria, ...vtwilio.IncomingPhoneNumberOption) error); ok {
+		r1 = rf(ctx, criteria, config...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QuarantineNumber provides a mock function with given fields: sid, grace, opts
+func (_m *Interface) QuarantineNumber(sid string, grace time.Duration, opts ...vtwilio.ReleaseOption) (*vtwilio.IncomingPhoneNumber, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, sid, grace)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *vtwilio.IncomingPhoneNumber
-	if rf, ok := ret.Get(0).(func(string, ...vtwilio.IncomingPhoneNumberOption) *vtwilio.IncomingPhoneNumber); ok {
-		r0 = rf(number, opts...)
+	if rf, ok := ret.Get(0).(func(string, time.Duration, ...vtwilio.ReleaseOption) *vtwilio.IncomingPhoneNumber); ok {
+		r0 = rf(sid, grace, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*vtwilio.IncomingPhoneNumber)
@@ -83,8 +1045,8 @@ func (_m *Interface) IncomingPhoneNumber(number string, opts ...vtwilio.Incoming
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, ...vtwilio.IncomingPhoneNumberOption) error); ok {
-		r1 = rf(number, opts...)
+	if rf, ok := ret.Get(1).(func(string, time.Duration, ...vtwilio.ReleaseOption) error); ok {
+		r1 = rf(sid, grace, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -92,28 +1054,29 @@ func (_m *Interface) IncomingPhoneNumber(number string, opts ...vtwilio.Incoming
 	return r0, r1
 }
 
-// ListMessages provides a mock function with given fields: opts
-func (_m *Interface) ListMessages(opts ...vtwilio.ListOption) (*vtwilio.List, error) {
+// RedirectCall provides a mock function with given fields: sid, opts
+func (_m *Interface) RedirectCall(sid string, opts ...vtwilio.CallOption) (*vtwilio.Call, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, sid)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *vtwilio.List
-	if rf, ok := ret.Get(0).(func(...vtwilio.ListOption) *vtwilio.List); ok {
-		r0 = rf(opts...)
+	var r0 *vtwilio.Call
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.CallOption) *vtwilio.Call); ok {
+		r0 = rf(sid, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*vtwilio.List)
+			r0 = ret.Get(0).(*vtwilio.Call)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(...vtwilio.ListOption) error); ok {
-		r1 = rf(opts...)
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.CallOption) error); ok {
+		r1 = rf(sid, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -121,13 +1084,57 @@ func (_m *Interface) ListMessages(opts ...vtwilio.ListOption) (*vtwilio.List, er
 	return r0, r1
 }
 
-// ReleaseNumber provides a mock function with given fields: sid
-func (_m *Interface) ReleaseNumber(sid string) error {
-	ret := _m.Called(sid)
+// ReleaseNumber provides a mock function with given fields: sid, opts
+func (_m *Interface) ReleaseNumber(sid string, opts ...vtwilio.ReleaseOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, sid)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(sid)
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.ReleaseOption) error); ok {
+		r0 = rf(sid, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseQuarantined provides a mock function with given fields: 
+func (_m *Interface) ReleaseQuarantined() (*vtwilio.QuarantineRelease, error) {
+	ret := _m.Called()
+
+	var r0 *vtwilio.QuarantineRelease
+	if rf, ok := ret.Get(0).(func() *vtwilio.QuarantineRelease); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.QuarantineRelease)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveParticipant provides a mock function with given fields: conferenceSID, callSID
+func (_m *Interface) RemoveParticipant(conferenceSID string, callSID string) error {
+	ret := _m.Called(conferenceSID, callSID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(conferenceSID, callSID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -135,6 +1142,29 @@ func (_m *Interface) ReleaseNumber(sid string) error {
 	return r0
 }
 
+// ResumeCallRecording provides a mock function with given fields: callSID, recordingSID
+func (_m *Interface) ResumeCallRecording(callSID string, recordingSID string) (*vtwilio.Recording, error) {
+	ret := _m.Called(callSID, recordingSID)
+
+	var r0 *vtwilio.Recording
+	if rf, ok := ret.Get(0).(func(string, string) *vtwilio.Recording); ok {
+		r0 = rf(callSID, recordingSID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Recording)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(callSID, recordingSID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendMessage provides a mock function with given fields: message, to, opts
 func (_m *Interface) SendMessage(message string, to string, opts ...vtwilio.SendOption) (*vtwilio.Message, error) {
 	_va := make([]interface{}, len(opts))
@@ -165,6 +1195,36 @@ func (_m *Interface) SendMessage(message string, to string, opts ...vtwilio.Send
 	return r0, r1
 }
 
+// SendTemplated provides a mock function with given fields: to, name, locale, data, opts
+func (_m *Interface) SendTemplated(to string, name string, locale string, data map[string]interface{}, opts ...vtwilio.SendOption) (*vtwilio.Message, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, to, name, locale, data)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.Message
+	if rf, ok := ret.Get(0).(func(string, string, string, map[string]interface{}, ...vtwilio.SendOption) *vtwilio.Message); ok {
+		r0 = rf(to, name, locale, data, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Message)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, map[string]interface{}, ...vtwilio.SendOption) error); ok {
+		r1 = rf(to, name, locale, data, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetPhoneNumber provides a mock function with given fields: n
 func (_m *Interface) SetPhoneNumber(n string) *vtwilio.VTwilio {
 	ret := _m.Called(n)
@@ -181,6 +1241,59 @@ func (_m *Interface) SetPhoneNumber(n string) *vtwilio.VTwilio {
 	return r0
 }
 
+// StartCallRecording provides a mock function with given fields: callSID, opts
+func (_m *Interface) StartCallRecording(callSID string, opts ...vtwilio.CallRecordingOption) (*vtwilio.Recording, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, callSID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.Recording
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.CallRecordingOption) *vtwilio.Recording); ok {
+		r0 = rf(callSID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Recording)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.CallRecordingOption) error); ok {
+		r1 = rf(callSID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopCallRecording provides a mock function with given fields: callSID, recordingSID
+func (_m *Interface) StopCallRecording(callSID string, recordingSID string) (*vtwilio.Recording, error) {
+	ret := _m.Called(callSID, recordingSID)
+
+	var r0 *vtwilio.Recording
+	if rf, ok := ret.Get(0).(func(string, string) *vtwilio.Recording); ok {
+		r0 = rf(callSID, recordingSID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Recording)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(callSID, recordingSID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateIncomingPhoneNumber provides a mock function with given fields: number, sid, opts
 func (_m *Interface) UpdateIncomingPhoneNumber(number string, sid string, opts ...vtwilio.IncomingPhoneNumberOption) (*vtwilio.IncomingPhoneNumber, error) {
 	_va := make([]interface{}, len(opts))
@@ -210,3 +1323,33 @@ func (_m *Interface) UpdateIncomingPhoneNumber(number string, sid string, opts .
 
 	return r0, r1
 }
+
+// UpdateQueue provides a mock function with given fields: sid, opts
+func (_m *Interface) UpdateQueue(sid string, opts ...vtwilio.QueueOption) (*vtwilio.Queue, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, sid)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *vtwilio.Queue
+	if rf, ok := ret.Get(0).(func(string, ...vtwilio.QueueOption) *vtwilio.Queue); ok {
+		r0 = rf(sid, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vtwilio.Queue)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...vtwilio.QueueOption) error); ok {
+		r1 = rf(sid, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,274 @@
+package vtwilio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// NumberConfig is the desired configuration of one phone number.
+// Settings left out are not managed and are left as they are on Twilio,
+// a setting set to "" is cleared.
+type NumberConfig struct {
+	FriendlyName         *string `json:"friendly_name,omitempty" yaml:"friendly_name,omitempty"`
+	VoiceURL             *string `json:"voice_url,omitempty" yaml:"voice_url,omitempty"`
+	VoiceMethod          *string `json:"voice_method,omitempty" yaml:"voice_method,omitempty"`
+	VoiceFallbackURL     *string `json:"voice_fallback_url,omitempty" yaml:"voice_fallback_url,omitempty"`
+	VoiceFallbackMethod  *string `json:"voice_fallback_method,omitempty" yaml:"voice_fallback_method,omitempty"`
+	StatusCallback       *string `json:"status_callback,omitempty" yaml:"status_callback,omitempty"`
+	StatusCallbackMethod *string `json:"status_callback_method,omitempty" yaml:"status_callback_method,omitempty"`
+	VoiceApplicationSID  *string `json:"voice_application_sid,omitempty" yaml:"voice_application_sid,omitempty"`
+	SMSURL               *string `json:"sms_url,omitempty" yaml:"sms_url,omitempty"`
+	SMSMethod            *string `json:"sms_method,omitempty" yaml:"sms_method,omitempty"`
+	SMSFallbackURL       *string `json:"sms_fallback_url,omitempty" yaml:"sms_fallback_url,omitempty"`
+	SMSFallbackMethod    *string `json:"sms_fallback_method,omitempty" yaml:"sms_fallback_method,omitempty"`
+	SMSApplicationSID    *string `json:"sms_application_sid,omitempty" yaml:"sms_application_sid,omitempty"`
+}
+
+// NumberState is the desired configuration of the account's phone numbers, keyed by number
+type NumberState struct {
+	Numbers map[string]NumberConfig `json:"numbers" yaml:"numbers"`
+}
+
+// numberSetting ties a NumberConfig field to the number's current value and the parameter that changes it
+type numberSetting struct {
+	name    string
+	desired func(*NumberConfig) *string
+	actual  func(*IncomingPhoneNumber) string
+	param   string
+}
+
+// same reports whether a desired value matches the current one, methods are not case sensitive
+func (s numberSetting) same(want, have string) bool {
+	if strings.HasSuffix(s.name, "_method") {
+		return strings.EqualFold(want, have)
+	}
+	return want == have
+}
+
+var numberSettings = []numberSetting{
+	{"friendly_name", func(c *NumberConfig) *string { return c.FriendlyName }, func(n *IncomingPhoneNumber) string { return n.FriendlyName }, "FriendlyName"},
+	{"voice_url", func(c *NumberConfig) *string { return c.VoiceURL }, func(n *IncomingPhoneNumber) string { return n.VoiceURL }, "VoiceUrl"},
+	{"voice_method", func(c *NumberConfig) *string { return c.VoiceMethod }, func(n *IncomingPhoneNumber) string { return n.VoiceMethod }, "VoiceMethod"},
+	{"voice_fallback_url", func(c *NumberConfig) *string { return c.VoiceFallbackURL }, func(n *IncomingPhoneNumber) string { return n.VoiceFallbackURL }, "VoiceFallbackUrl"},
+	{"voice_fallback_method", func(c *NumberConfig) *string { return c.VoiceFallbackMethod }, func(n *IncomingPhoneNumber) string { return n.VoiceFallbackMethod }, "VoiceFallbackMethod"},
+	{"status_callback", func(c *NumberConfig) *string { return c.StatusCallback }, func(n *IncomingPhoneNumber) string { return n.StatusCallback }, "StatusCallback"},
+	{"status_callback_method", func(c *NumberConfig) *string { return c.StatusCallbackMethod }, func(n *IncomingPhoneNumber) string { return n.StatusCallbackMethod }, "StatusCallbackMethod"},
+	{"voice_application_sid", func(c *NumberConfig) *string { return c.VoiceApplicationSID }, func(n *IncomingPhoneNumber) string { return n.VoiceApplicationSID }, "VoiceApplicationSid"},
+	{"sms_url", func(c *NumberConfig) *string { return c.SMSURL }, func(n *IncomingPhoneNumber) string { return n.SMSURL }, "SmsUrl"},
+	{"sms_method", func(c *NumberConfig) *string { return c.SMSMethod }, func(n *IncomingPhoneNumber) string { return n.SMSMethod }, "SmsMethod"},
+	{"sms_fallback_url", func(c *NumberConfig) *string { return c.SMSFallbackURL }, func(n *IncomingPhoneNumber) string { return n.SMSFallbackURL }, "SmsFallbackUrl"},
+	{"sms_fallback_method", func(c *NumberConfig) *string { return c.SMSFallbackMethod }, func(n *IncomingPhoneNumber) string { return n.SMSFallbackMethod }, "SmsFallbackMethod"},
+	{"sms_application_sid", func(c *NumberConfig) *string { return c.SMSApplicationSID }, func(n *IncomingPhoneNumber) string { return n.SMSApplicationSID }, "SmsApplicationSid"},
+}
+
+// LoadNumberState reads a desired state file, files ending in .json are read as JSON and any other file as YAML
+func LoadNumberState(path string) (*NumberState, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return parseNumberState(data, jsonUnmarshalStrict)
+	}
+	return ParseNumberState(data)
+}
+
+// ParseNumberState parses a desired state document, documents starting with { are parsed as JSON and any other as YAML
+func ParseNumberState(data []byte) (*NumberState, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return parseNumberState(data, jsonUnmarshalStrict)
+	}
+	return parseNumberState(data, yaml.UnmarshalStrict)
+}
+
+// jsonUnmarshalStrict rejects unknown settings the same way yaml.UnmarshalStrict does
+func jsonUnmarshalStrict(data []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	return d.Decode(v)
+}
+
+func parseNumberState(data []byte, unmarshal func([]byte, interface{}) error) (*NumberState, error) {
+	state := &NumberState{}
+	if err := unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("invalid number state: %v", err)
+	}
+	return state, nil
+}
+
+// NumberChange is a setting of a number that differs from its desired state
+type NumberChange struct {
+	Setting string
+	From    string
+	To      string
+}
+
+// NumberPlan is the changes needed to bring one number to its desired state
+type NumberPlan struct {
+	Number  string
+	SID     string
+	Changes []NumberChange
+}
+
+// NumbersPlan is the changes needed to bring the account's numbers to their desired state
+type NumbersPlan struct {
+	Numbers []*NumberPlan
+	// Unowned are numbers in the desired state that the account does not own
+	Unowned []string
+}
+
+// Empty reports whether the plan has nothing to apply
+func (p *NumbersPlan) Empty() bool {
+	return len(p.Numbers) == 0
+}
+
+// String writes the plan as a readable diff
+func (p *NumbersPlan) String() string {
+	var b strings.Builder
+	for _, n := range p.Numbers {
+		fmt.Fprintf(&b, "~ %v (%v)\n", n.Number, n.SID)
+		for _, c := range n.Changes {
+			fmt.Fprintf(&b, "    %v: %q -> %q\n", c.Setting, c.From, c.To)
+		}
+	}
+	for _, n := range p.Unowned {
+		fmt.Fprintf(&b, "! %v is not owned by the account\n", n)
+	}
+	if b.Len() == 0 {
+		return "No changes\n"
+	}
+	return b.String()
+}
+
+// PlanNumbers compares the desired state with the account's numbers and returns the changes needed
+func (v *VTwilio) PlanNumbers(state *NumberState) (*NumbersPlan, error) {
+	desired := map[string]NumberConfig{}
+	for n, c := range state.Numbers {
+		normalized, err := v.normalizeNumber(n)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", n, err)
+		}
+		if _, ok := desired[normalized]; ok {
+			return nil, fmt.Errorf("%v is configured more than once", normalized)
+		}
+		desired[normalized] = c
+	}
+
+	owned, err := v.allIncomingPhoneNumbers()
+	if err != nil {
+		return nil, err
+	}
+	actual := map[string]*IncomingPhoneNumber{}
+	for _, n := range owned {
+		actual[n.PhoneNumber] = n
+	}
+
+	plan := &NumbersPlan{}
+	for number, config := range desired {
+		current, ok := actual[number]
+		if !ok {
+			plan.Unowned = append(plan.Unowned, number)
+			continue
+		}
+		changes := []NumberChange{}
+		for _, s := range numberSettings {
+			want, have := s.desired(&config), s.actual(current)
+			if want == nil || s.same(*want, have) {
+				continue
+			}
+			changes = append(changes, NumberChange{Setting: s.name, From: have, To: *want})
+		}
+		if len(changes) > 0 {
+			plan.Numbers = append(plan.Numbers, &NumberPlan{Number: number, SID: current.SID, Changes: changes})
+		}
+	}
+
+	sort.Slice(plan.Numbers, func(i, j int) bool { return plan.Numbers[i].Number < plan.Numbers[j].Number })
+	sort.Strings(plan.Unowned)
+	return plan, nil
+}
+
+type applyConfiguration struct {
+	DryRun bool
+}
+
+// ApplyOption is an option for applying a plan
+type ApplyOption func(*applyConfiguration)
+
+// ApplyDryRun reports what applying the plan would change without changing anything
+func ApplyDryRun() ApplyOption {
+	return func(a *applyConfiguration) {
+		a.DryRun = true
+	}
+}
+
+// ApplyResult is the outcome of applying a plan
+type ApplyResult struct {
+	DryRun bool
+	// Applied are the numbers that were updated, or would be in a dry run
+	Applied []string
+	// Failed maps each number that could not be updated to the error
+	Failed map[string]error
+}
+
+// ApplyNumbersPlan makes the changes in a plan, one update per number
+func (v *VTwilio) ApplyNumbersPlan(plan *NumbersPlan, opts ...ApplyOption) (*ApplyResult, error) {
+	c := &applyConfiguration{}
+	for _, o := range opts {
+		o(c)
+	}
+
+	result := &ApplyResult{DryRun: c.DryRun, Failed: map[string]error{}}
+	for _, n := range plan.Numbers {
+		if c.DryRun {
+			result.Applied = append(result.Applied, n.Number)
+			continue
+		}
+		if err := v.applyNumberPlan(n); err != nil {
+			result.Failed[n.Number] = err
+			continue
+		}
+		result.Applied = append(result.Applied, n.Number)
+	}
+
+	if len(result.Failed) > 0 {
+		return result, fmt.Errorf("failed to update %v of %v numbers", len(result.Failed), len(plan.Numbers))
+	}
+	return result, nil
+}
+
+// applyNumberPlan updates a number with the values in its plan, values are posted as they are so a
+// setting can be cleared
+func (v *VTwilio) applyNumberPlan(n *NumberPlan) error {
+	values := url.Values{}
+	values.Set("PhoneNumber", n.Number)
+	for _, c := range n.Changes {
+		for _, s := range numberSettings {
+			if s.name != c.Setting {
+				continue
+			}
+			value := c.To
+			if strings.HasSuffix(s.name, "_method") {
+				value = strings.ToUpper(value)
+			}
+			values.Set(s.param, value)
+		}
+	}
+
+	req, err := http.NewRequest("POST", buildIncomingPhoneNumber(v.baseAPI, v.accountSID, n.SID), strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	_, err = handleIncomingPhoneNumbers(req)
+	return err
+}
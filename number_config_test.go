@@ -0,0 +1,152 @@
+package vtwilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const numberStateYAML = `
+numbers:
+  "+13065550001":
+    sms_url: https://example.com/sms
+    sms_method: post
+  "(306) 555-0002":
+    friendly_name: Support
+    voice_url: https://example.com/voice
+  "+13065550009":
+    sms_url: https://example.com/sms
+`
+
+func TestParseNumberState(t *testing.T) {
+	expected := &NumberState{Numbers: map[string]NumberConfig{
+		"+13065550001": {SMSURL: stringPtr("https://example.com/sms"), SMSMethod: stringPtr("post")},
+	}}
+
+	actual, err := ParseNumberState([]byte(`numbers: {"+13065550001": {sms_url: "https://example.com/sms", sms_method: post}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	actual, err = ParseNumberState([]byte(`{"numbers": {"+13065550001": {"sms_url": "https://example.com/sms", "sms_method": "post"}}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	_, err = ParseNumberState([]byte(`numbers: {"+13065550001": {sms_ulr: "https://example.com/sms"}}`))
+	assert.Error(t, err)
+	_, err = ParseNumberState([]byte(`{"numbers": {"+13065550001": {"sms_ulr": "https://example.com/sms"}}}`))
+	assert.Error(t, err)
+}
+
+func TestLoadNumberState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "numbers")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "numbers.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(numberStateYAML), 0644))
+	state, err := LoadNumberState(path)
+	assert.NoError(t, err)
+	assert.Len(t, state.Numbers, 3)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func numberConfigServer(updates map[string]url.Values) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"incoming_phone_numbers": [
+				{"sid": "PN1", "phone_number": "+13065550001", "sms_url": "https://old.example.com/sms", "sms_method": "POST", "sms_fallback_url": "https://example.com/fallback"},
+				{"sid": "PN2", "phone_number": "+13065550002", "friendly_name": "Support", "voice_url": ""},
+				{"sid": "PN3", "phone_number": "+13065550003"}
+			]}`)
+			return
+		}
+		r.ParseForm()
+		updates[r.URL.Path] = r.PostForm
+		fmt.Fprint(w, `{}`)
+	}))
+}
+
+func TestPlanAndApplyNumbers(t *testing.T) {
+	updates := map[string]url.Values{}
+	ts := numberConfigServer(updates)
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL), region: "CA"}
+	state, err := ParseNumberState([]byte(numberStateYAML))
+	assert.NoError(t, err)
+
+	plan, err := v.PlanNumbers(state)
+	assert.NoError(t, err)
+	assert.Equal(t, &NumbersPlan{
+		Numbers: []*NumberPlan{
+			{Number: "+13065550001", SID: "PN1", Changes: []NumberChange{{Setting: "sms_url", From: "https://old.example.com/sms", To: "https://example.com/sms"}}},
+			{Number: "+13065550002", SID: "PN2", Changes: []NumberChange{{Setting: "voice_url", From: "", To: "https://example.com/voice"}}},
+		},
+		Unowned: []string{"+13065550009"},
+	}, plan)
+	assert.Equal(t, `~ +13065550001 (PN1)
+    sms_url: "https://old.example.com/sms" -> "https://example.com/sms"
+~ +13065550002 (PN2)
+    voice_url: "" -> "https://example.com/voice"
+! +13065550009 is not owned by the account
+`, plan.String())
+
+	result, err := v.ApplyNumbersPlan(plan, ApplyDryRun())
+	assert.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, []string{"+13065550001", "+13065550002"}, result.Applied)
+	assert.Empty(t, updates)
+
+	result, err = v.ApplyNumbersPlan(plan)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"+13065550001", "+13065550002"}, result.Applied)
+	assert.Equal(t, map[string]url.Values{
+		"/sid/IncomingPhoneNumbers/PN1.json": {"PhoneNumber": {"+13065550001"}, "SmsUrl": {"https://example.com/sms"}},
+		"/sid/IncomingPhoneNumbers/PN2.json": {"PhoneNumber": {"+13065550002"}, "VoiceUrl": {"https://example.com/voice"}},
+	}, updates)
+}
+
+func TestPlanAndApplyNumbersClearSetting(t *testing.T) {
+	updates := map[string]url.Values{}
+	ts := numberConfigServer(updates)
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	state, err := ParseNumberState([]byte(`numbers: {"+13065550001": {sms_fallback_url: ""}, "+13065550002": {voice_url: ""}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, stringPtr(""), state.Numbers["+13065550001"].SMSFallbackURL)
+	assert.Nil(t, state.Numbers["+13065550001"].SMSURL)
+
+	plan, err := v.PlanNumbers(state)
+	assert.NoError(t, err)
+	assert.Equal(t, []*NumberPlan{
+		{Number: "+13065550001", SID: "PN1", Changes: []NumberChange{{Setting: "sms_fallback_url", From: "https://example.com/fallback", To: ""}}},
+	}, plan.Numbers)
+
+	_, err = v.ApplyNumbersPlan(plan)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]url.Values{
+		"/sid/IncomingPhoneNumbers/PN1.json": {"PhoneNumber": {"+13065550001"}, "SmsFallbackUrl": {""}},
+	}, updates)
+}
+
+func TestPlanNumbersNoChanges(t *testing.T) {
+	ts := numberConfigServer(map[string]url.Values{})
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	plan, err := v.PlanNumbers(&NumberState{Numbers: map[string]NumberConfig{"+13065550002": {FriendlyName: stringPtr("Support")}}})
+	assert.NoError(t, err)
+	assert.True(t, plan.Empty())
+	assert.Equal(t, "No changes\n", plan.String())
+}
@@ -0,0 +1,248 @@
+package vtwilio
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/twiebe-va/vtwilio-go/phonenumber"
+)
+
+const (
+	defaultPoolMaxFailures = 3
+	defaultPoolCooldown    = 10 * time.Minute
+)
+
+// StickyStore remembers which pool number each recipient was last sent from
+type StickyStore interface {
+	Get(recipient string) (number string, ok bool, err error)
+	Set(recipient, number string) error
+}
+
+// MemoryStickyStore is a StickyStore that keeps assignments in memory
+type MemoryStickyStore struct {
+	mu          sync.RWMutex
+	assignments map[string]string
+}
+
+// NewMemoryStickyStore returns an empty in memory sticky store
+func NewMemoryStickyStore() *MemoryStickyStore {
+	return &MemoryStickyStore{assignments: map[string]string{}}
+}
+
+// Get returns the number assigned to a recipient
+func (s *MemoryStickyStore) Get(recipient string) (string, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n, ok := s.assignments[recipient]
+	return n, ok, nil
+}
+
+// Set assigns a number to a recipient
+func (s *MemoryStickyStore) Set(recipient, number string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.assignments[recipient] = number
+	return nil
+}
+
+// NumberPool chooses which of several numbers a message is sent from. A recipient keeps the
+// number they were first sent from while it is healthy, new recipients get the least used
+// number in their area code, then in their country, then anywhere.
+type NumberPool struct {
+	mu          sync.Mutex
+	numbers     []*poolNumber
+	store       StickyStore
+	maxFailures int
+	cooldown    time.Duration
+	now         func() time.Time
+}
+
+type poolNumber struct {
+	number        *phonenumber.PhoneNumber
+	sent          int
+	failures      int
+	excludedUntil time.Time
+}
+
+// NumberPoolOption is an option for a number pool
+type NumberPoolOption func(*NumberPool)
+
+// PoolStickyStore sets where recipient assignments are kept, defaults to a MemoryStickyStore
+func PoolStickyStore(s StickyStore) NumberPoolOption {
+	return func(p *NumberPool) {
+		p.store = s
+	}
+}
+
+// PoolMaxFailures is how many sends in a row may fail before a number is excluded, defaults to 3
+func PoolMaxFailures(n int) NumberPoolOption {
+	return func(p *NumberPool) {
+		p.maxFailures = n
+	}
+}
+
+// PoolCooldown is how long an unhealthy number is excluded for, defaults to 10 minutes
+func PoolCooldown(d time.Duration) NumberPoolOption {
+	return func(p *NumberPool) {
+		p.cooldown = d
+	}
+}
+
+// PoolClock overrides the clock used to end a number's exclusion
+func PoolClock(now func() time.Time) NumberPoolOption {
+	return func(p *NumberPool) {
+		p.now = now
+	}
+}
+
+// NewNumberPool returns a pool of E.164 sender numbers
+func NewNumberPool(numbers []string, opts ...NumberPoolOption) (*NumberPool, error) {
+	if len(numbers) == 0 {
+		return nil, fmt.Errorf("number pool must contain at least one number")
+	}
+	p := &NumberPool{
+		store:       NewMemoryStickyStore(),
+		maxFailures: defaultPoolMaxFailures,
+		cooldown:    defaultPoolCooldown,
+		now:         time.Now,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+
+	seen := map[string]bool{}
+	for _, n := range numbers {
+		parsed, err := phonenumber.Parse(n, "")
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", n, err)
+		}
+		if seen[parsed.String()] {
+			continue
+		}
+		seen[parsed.String()] = true
+		p.numbers = append(p.numbers, &poolNumber{number: parsed})
+	}
+	return p, nil
+}
+
+// Choose returns the number to send to a recipient from and remembers the choice. While a recipient's
+// number is unhealthy another number is used for the send, but the recipient keeps their number.
+func (p *NumberPool) Choose(to string) (string, error) {
+	recipient, err := phonenumber.Parse(to, "")
+	if err != nil {
+		return "", err
+	}
+
+	sticky, assigned, err := p.store.Get(recipient.String())
+	if err != nil {
+		return "", err
+	}
+
+	number, remember, err := p.choose(recipient, sticky, assigned)
+	if err != nil {
+		return "", err
+	}
+	if remember {
+		if err := p.store.Set(recipient.String(), number); err != nil {
+			return "", err
+		}
+	}
+	return number, nil
+}
+
+// choose picks a number for a recipient and reports whether it should be remembered, the choice
+// is only remembered when the recipient has no number in the pool yet
+func (p *NumberPool) choose(recipient *phonenumber.PhoneNumber, sticky string, assigned bool) (string, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var current *poolNumber
+	if assigned {
+		current = p.find(sticky)
+	}
+	if current != nil && p.healthy(current) {
+		current.sent++
+		return sticky, false, nil
+	}
+
+	var best *poolNumber
+	bestScore := -1
+	for _, n := range p.numbers {
+		if !p.healthy(n) {
+			continue
+		}
+		score := matchScore(n.number, recipient)
+		if score > bestScore || score == bestScore && n.sent < best.sent {
+			best, bestScore = n, score
+		}
+	}
+	if best == nil {
+		return "", false, fmt.Errorf("no healthy numbers in the pool")
+	}
+	best.sent++
+	return best.number.String(), current == nil, nil
+}
+
+// Succeeded records a successful send from a pool number
+func (p *NumberPool) Succeeded(number string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n := p.find(number); n != nil {
+		n.failures = 0
+	}
+}
+
+// Failed records a failed send from a pool number, numbers that fail too often in a row are
+// excluded from the pool until the cooldown passes
+func (p *NumberPool) Failed(number string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n := p.find(number)
+	if n == nil {
+		return
+	}
+	n.failures++
+	if n.failures >= p.maxFailures {
+		n.failures = 0
+		n.excludedUntil = p.now().Add(p.cooldown)
+	}
+}
+
+// Healthy returns the numbers that are not excluded
+func (p *NumberPool) Healthy() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	healthy := []string{}
+	for _, n := range p.numbers {
+		if p.healthy(n) {
+			healthy = append(healthy, n.number.String())
+		}
+	}
+	return healthy
+}
+
+func (p *NumberPool) healthy(n *poolNumber) bool {
+	return !p.now().Before(n.excludedUntil)
+}
+
+func (p *NumberPool) find(number string) *poolNumber {
+	for _, n := range p.numbers {
+		if n.number.String() == number {
+			return n
+		}
+	}
+	return nil
+}
+
+// matchScore is 2 when a sender shares the recipient's area code, 1 when it shares their country and 0 otherwise.
+// Area codes are only compared for North American numbers.
+func matchScore(sender, recipient *phonenumber.PhoneNumber) int {
+	if sender.CountryCode != recipient.CountryCode {
+		return 0
+	}
+	if sender.CountryCode == 1 && sender.NationalNumber[:3] == recipient.NationalNumber[:3] {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,152 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberPoolChoose(t *testing.T) {
+	p, err := NewNumberPool([]string{"+14155550001", "+14155550002", "+13065550001", "+442071838750"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		to       string
+		expected string
+	}{
+		{name: "area code match", to: "+14155559999", expected: "+14155550001"},
+		{name: "area code load balanced", to: "+14155558888", expected: "+14155550002"},
+		{name: "sticky", to: "+14155559999", expected: "+14155550001"},
+		{name: "country match", to: "+12125550000", expected: "+13065550001"},
+		{name: "other country", to: "+442079460000", expected: "+442071838750"},
+		{name: "no match uses least used", to: "+61291234567", expected: "+14155550002"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := p.Choose(tt.to)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestNumberPoolHealth(t *testing.T) {
+	now := time.Date(2018, time.March, 2, 12, 0, 0, 0, time.UTC)
+	p, err := NewNumberPool([]string{"+14155550001", "+14155550002"},
+		PoolMaxFailures(2),
+		PoolCooldown(time.Minute),
+		PoolClock(func() time.Time { return now }))
+	assert.NoError(t, err)
+
+	from, err := p.Choose("+14155559999")
+	assert.NoError(t, err)
+	assert.Equal(t, "+14155550001", from)
+
+	p.Failed(from)
+	p.Succeeded(from)
+	p.Failed(from)
+	assert.Equal(t, []string{"+14155550001", "+14155550002"}, p.Healthy())
+	p.Failed(from)
+	assert.Equal(t, []string{"+14155550002"}, p.Healthy())
+
+	from, err = p.Choose("+14155559999")
+	assert.NoError(t, err)
+	assert.Equal(t, "+14155550002", from, "sticky number is skipped while unhealthy")
+
+	p.Failed(from)
+	p.Failed(from)
+	_, err = p.Choose("+14155557777")
+	assert.Equal(t, fmt.Errorf("no healthy numbers in the pool"), err)
+
+	now = now.Add(time.Minute)
+	assert.Len(t, p.Healthy(), 2)
+	from, err = p.Choose("+14155559999")
+	assert.NoError(t, err)
+	assert.Equal(t, "+14155550001", from, "recipient returns to their number once it is healthy")
+}
+
+// poolCheckingStore fails the test if the pool's lock is held while the store is used
+type poolCheckingStore struct {
+	*MemoryStickyStore
+	pool *NumberPool
+}
+
+func (s *poolCheckingStore) Get(recipient string) (string, bool, error) {
+	s.pool.Healthy()
+	return s.MemoryStickyStore.Get(recipient)
+}
+
+func (s *poolCheckingStore) Set(recipient, number string) error {
+	s.pool.Healthy()
+	return s.MemoryStickyStore.Set(recipient, number)
+}
+
+func TestNumberPoolStoreOutsideLock(t *testing.T) {
+	store := &poolCheckingStore{MemoryStickyStore: NewMemoryStickyStore()}
+	p, err := NewNumberPool([]string{"+14155550001"}, PoolStickyStore(store))
+	assert.NoError(t, err)
+	store.pool = p
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		from, err := p.Choose("+14155559999")
+		assert.NoError(t, err)
+		assert.Equal(t, "+14155550001", from)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Choose deadlocked using the store")
+	}
+}
+
+func TestNewNumberPool(t *testing.T) {
+	_, err := NewNumberPool(nil)
+	assert.Equal(t, fmt.Errorf("number pool must contain at least one number"), err)
+
+	_, err = NewNumberPool([]string{"4155550001"})
+	assert.Equal(t, fmt.Errorf("4155550001: phone number must begin with + or a default region must be set"), err)
+}
+
+func TestSendMessageFromPool(t *testing.T) {
+	rejection := `{"code": 21211, "message": "invalid To number"}`
+	var from []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from = append(from, r.FormValue("From"))
+		if rejection != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, rejection)
+			return
+		}
+		fmt.Fprint(w, `{"sid": "SM123"}`)
+	}))
+	defer ts.Close()
+
+	p, err := NewNumberPool([]string{"+14155550001", "+14155550002"}, PoolMaxFailures(1))
+	assert.NoError(t, err)
+	v := NewVTwilio("sid", "token", SenderPool(p))
+	v.baseAPI = fmt.Sprintf("%s/", ts.URL)
+
+	_, err = v.SendMessage("hi", "+14155559999")
+	assert.Error(t, err)
+	assert.Equal(t, []string{"+14155550001", "+14155550002"}, p.Healthy(), "a bad recipient is not the number's fault")
+
+	rejection = `{"code": 21606, "message": "failed"}`
+	_, err = v.SendMessage("hi", "+14155559999")
+	assert.Error(t, err)
+	assert.Equal(t, []string{"+14155550002"}, p.Healthy())
+
+	rejection = ""
+	_, err = v.SendMessage("hi", "+14155559999")
+	assert.NoError(t, err)
+	_, err = v.SendMessage("hi", "+14155559999", FromNumber("+13065550000"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"+14155550001", "+14155550001", "+14155550002", "+13065550000"}, from)
+}
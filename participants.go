@@ -0,0 +1,208 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Participant is a call connected to a conference
+type Participant struct {
+	CallSID                string `json:"call_sid"`
+	ConferenceSID          string `json:"conference_sid"`
+	AccountSID             string `json:"account_sid"`
+	Label                  string `json:"label"`
+	Status                 string `json:"status"`
+	Muted                  bool   `json:"muted"`
+	Hold                   bool   `json:"hold"`
+	Coaching               bool   `json:"coaching"`
+	CallSIDToCoach         string `json:"call_sid_to_coach"`
+	StartConferenceOnEnter bool   `json:"start_conference_on_enter"`
+	EndConferenceOnExit    bool   `json:"end_conference_on_exit"`
+	DateCreated            string `json:"date_created"`
+	DateUpdated            string `json:"date_updated"`
+	URI                    string `json:"uri"`
+}
+
+// ParticipantList is a page of participants
+type ParticipantList struct {
+	FirstPageURI    string         `json:"first_page_uri"`
+	NextPageURI     string         `json:"next_page_uri"`
+	PreviousPageURI string         `json:"previous_page_uri"`
+	Page            int            `json:"page"`
+	PageSize        int            `json:"page_size"`
+	End             int            `json:"end"`
+	Participants    []*Participant `json:"participants"`
+}
+
+// ListParticipants returns a page of a conference's participants
+func (v *VTwilio) ListParticipants(conferenceSID string, opts ...ParticipantListOption) (*ParticipantList, error) {
+	if conferenceSID == "" {
+		return nil, fmt.Errorf("must contain a conference SID")
+	}
+	c := &participantListConfiguration{
+		PageSize: 10,
+		Page:     0,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	values := url.Values{}
+	values.Set("PageSize", strconv.Itoa(c.PageSize))
+	values.Set("Page", strconv.Itoa(c.Page))
+	if c.Muted != "" {
+		values.Set("Muted", c.Muted)
+	}
+	if c.Hold != "" {
+		values.Set("Hold", c.Hold)
+	}
+	if c.Coaching != "" {
+		values.Set("Coaching", c.Coaching)
+	}
+
+	urlStr := fmt.Sprintf("%s?%s", v.participantURL(conferenceSID, ""), values.Encode())
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleListParticipants(req)
+}
+
+// AddParticipant calls a number and connects it to a conference. When from is empty the client's number is used.
+func (v *VTwilio) AddParticipant(conferenceSID, to, from string, opts ...ParticipantOption) (*Participant, error) {
+	if conferenceSID == "" {
+		return nil, fmt.Errorf("must contain a conference SID")
+	}
+	to, err := v.normalizeNumber(to)
+	if err != nil {
+		return nil, err
+	}
+	if from == "" {
+		from = v.twilioNumber
+	}
+	from, err = v.normalizeNumber(from)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &participantConfiguration{}
+	for _, o := range opts {
+		o(c)
+	}
+
+	values := url.Values{}
+	values.Set("To", to)
+	values.Set("From", from)
+	if c.Label != "" {
+		values.Set("Label", c.Label)
+	}
+	if c.Muted {
+		values.Set("Muted", "true")
+	}
+	if c.Beep != "" {
+		values.Set("Beep", c.Beep)
+	}
+	if c.StartConferenceOnEnter != "" {
+		values.Set("StartConferenceOnEnter", c.StartConferenceOnEnter)
+	}
+	if c.EndConferenceOnExit != "" {
+		values.Set("EndConferenceOnExit", c.EndConferenceOnExit)
+	}
+	if c.CallSIDToCoach != "" {
+		values.Set("Coaching", "true")
+		values.Set("CallSidToCoach", c.CallSIDToCoach)
+	}
+	if c.StatusCallback != "" {
+		values.Set("StatusCallback", c.StatusCallback)
+		if c.StatusCallbackMethod != "" {
+			values.Set("StatusCallbackMethod", c.StatusCallbackMethod.String())
+		}
+		for _, e := range c.StatusCallbackEvents {
+			values.Add("StatusCallbackEvent", string(e))
+		}
+	}
+	if c.Timeout > 0 {
+		values.Set("Timeout", strconv.Itoa(c.Timeout))
+	}
+	if c.Record {
+		values.Set("Record", "true")
+	}
+	if c.WaitURL != "" {
+		values.Set("WaitUrl", c.WaitURL)
+	}
+
+	return v.postParticipant(v.participantURL(conferenceSID, ""), values)
+}
+
+// MuteParticipant mutes or unmutes a participant
+func (v *VTwilio) MuteParticipant(conferenceSID, callSID string, muted bool) (*Participant, error) {
+	return v.updateParticipant(conferenceSID, callSID, url.Values{"Muted": {strconv.FormatBool(muted)}})
+}
+
+// HoldParticipant puts a participant on or takes them off hold. holdURL is the TwiML or
+// audio played to the participant while on hold, Twilio plays its default music when it is empty.
+func (v *VTwilio) HoldParticipant(conferenceSID, callSID string, hold bool, holdURL string) (*Participant, error) {
+	values := url.Values{"Hold": {strconv.FormatBool(hold)}}
+	if hold && holdURL != "" {
+		values.Set("HoldUrl", holdURL)
+	}
+	return v.updateParticipant(conferenceSID, callSID, values)
+}
+
+// CoachParticipant makes a participant a coach that only callSIDToCoach can hear,
+// an empty callSIDToCoach stops the participant coaching
+func (v *VTwilio) CoachParticipant(conferenceSID, callSID, callSIDToCoach string) (*Participant, error) {
+	values := url.Values{"Coaching": {"false"}}
+	if callSIDToCoach != "" {
+		values.Set("Coaching", "true")
+		values.Set("CallSidToCoach", callSIDToCoach)
+	}
+	return v.updateParticipant(conferenceSID, callSID, values)
+}
+
+// RemoveParticipant disconnects a participant from a conference
+func (v *VTwilio) RemoveParticipant(conferenceSID, callSID string) error {
+	if conferenceSID == "" {
+		return fmt.Errorf("must contain a conference SID")
+	}
+	if callSID == "" {
+		return fmt.Errorf("must contain a call SID")
+	}
+	req, err := http.NewRequest("DELETE", v.participantURL(conferenceSID, callSID), nil)
+	if err != nil {
+		return err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return genericHandler(req)
+}
+
+func (v *VTwilio) updateParticipant(conferenceSID, callSID string, values url.Values) (*Participant, error) {
+	if conferenceSID == "" {
+		return nil, fmt.Errorf("must contain a conference SID")
+	}
+	if callSID == "" {
+		return nil, fmt.Errorf("must contain a call SID")
+	}
+	return v.postParticipant(v.participantURL(conferenceSID, callSID), values)
+}
+
+func (v *VTwilio) postParticipant(urlStr string, values url.Values) (*Participant, error) {
+	req, err := http.NewRequest("POST", urlStr, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleParticipant(req)
+}
+
+func (v *VTwilio) participantURL(conferenceSID, callSID string) string {
+	urlStr := fmt.Sprintf("%s%s%s/%s%s", v.baseAPI, v.accountSID, conferencesAPI, conferenceSID, participantsAPI)
+	if callSID != "" {
+		urlStr = fmt.Sprintf("%s/%s", urlStr, callSID)
+	}
+	return fmt.Sprintf("%s.json", urlStr)
+}
@@ -0,0 +1,155 @@
+package vtwilio
+
+import (
+	"strconv"
+)
+
+// ParticipantEvent is a participant event Twilio can send to a status callback
+type ParticipantEvent string
+
+const (
+	// ParticipantInitiated event
+	ParticipantInitiated ParticipantEvent = "initiated"
+	// ParticipantRinging event
+	ParticipantRinging ParticipantEvent = "ringing"
+	// ParticipantAnswered event
+	ParticipantAnswered ParticipantEvent = "answered"
+	// ParticipantCompleted event
+	ParticipantCompleted ParticipantEvent = "completed"
+)
+
+type participantConfiguration struct {
+	Label                  string
+	Muted                  bool
+	Beep                   string
+	StartConferenceOnEnter string
+	EndConferenceOnExit    string
+	CallSIDToCoach         string
+	StatusCallback         string
+	StatusCallbackMethod   Method
+	StatusCallbackEvents   []ParticipantEvent
+	Timeout                int
+	Record                 bool
+	WaitURL                string
+}
+
+// ParticipantOption is an option for a participant being added to a conference
+type ParticipantOption func(*participantConfiguration)
+
+// ParticipantLabel is a name for the participant that can be used in place of its call sid
+func ParticipantLabel(l string) ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.Label = l
+	}
+}
+
+// ParticipantMuted adds the participant muted
+func ParticipantMuted() ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.Muted = true
+	}
+}
+
+// ParticipantBeep sets whether a beep plays when the participant joins and leaves
+func ParticipantBeep(b bool) ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.Beep = strconv.FormatBool(b)
+	}
+}
+
+// ParticipantStartConferenceOnEnter sets whether the conference starts when the participant joins
+func ParticipantStartConferenceOnEnter(s bool) ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.StartConferenceOnEnter = strconv.FormatBool(s)
+	}
+}
+
+// ParticipantEndConferenceOnExit sets whether the conference ends when the participant leaves
+func ParticipantEndConferenceOnExit(e bool) ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.EndConferenceOnExit = strconv.FormatBool(e)
+	}
+}
+
+// ParticipantCoaching adds the participant as a coach who can only be heard by the call being coached
+func ParticipantCoaching(callSIDToCoach string) ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.CallSIDToCoach = callSIDToCoach
+	}
+}
+
+// ParticipantStatusCallback is the url Twilio calls when the participant's call reaches one of the events
+func ParticipantStatusCallback(url string, method Method, events ...ParticipantEvent) ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.StatusCallback = url
+		p.StatusCallbackMethod = method
+		p.StatusCallbackEvents = events
+	}
+}
+
+// ParticipantTimeout is how many seconds to let the participant's phone ring, Twilio defaults to 60
+func ParticipantTimeout(seconds int) ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.Timeout = seconds
+	}
+}
+
+// ParticipantRecord records the participant's call
+func ParticipantRecord() ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.Record = true
+	}
+}
+
+// ParticipantWaitURL is the url of TwiML or audio played while waiting for the conference to start
+func ParticipantWaitURL(url string) ParticipantOption {
+	return func(p *participantConfiguration) {
+		p.WaitURL = url
+	}
+}
+
+type participantListConfiguration struct {
+	Muted    string
+	Hold     string
+	Coaching string
+	PageSize int
+	Page     int
+}
+
+// ParticipantListOption is an option for listing participants
+type ParticipantListOption func(*participantListConfiguration)
+
+// ParticipantsMuted only lists participants that are or are not muted
+func ParticipantsMuted(m bool) ParticipantListOption {
+	return func(c *participantListConfiguration) {
+		c.Muted = strconv.FormatBool(m)
+	}
+}
+
+// ParticipantsOnHold only lists participants that are or are not on hold
+func ParticipantsOnHold(h bool) ParticipantListOption {
+	return func(c *participantListConfiguration) {
+		c.Hold = strconv.FormatBool(h)
+	}
+}
+
+// ParticipantsCoaching only lists participants that are or are not coaching
+func ParticipantsCoaching(co bool) ParticipantListOption {
+	return func(c *participantListConfiguration) {
+		c.Coaching = strconv.FormatBool(co)
+	}
+}
+
+// ParticipantsPageSize sets the page size, defaults to 10
+func ParticipantsPageSize(pageSize int) ParticipantListOption {
+	return func(c *participantListConfiguration) {
+		c.PageSize = pageSize
+	}
+}
+
+// ParticipantsPage sets the page number, defaults to 0
+func ParticipantsPage(page int) ParticipantListOption {
+	return func(c *participantListConfiguration) {
+		c.Page = page
+	}
+}
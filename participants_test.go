@@ -0,0 +1,162 @@
+package vtwilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListParticipants(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/sid/Conferences/CF123/Participants.json", r.URL.Path)
+		assert.Equal(t, url.Values{"Muted": {"true"}, "Hold": {"false"}, "PageSize": {"20"}, "Page": {"1"}}, r.URL.Query())
+		fmt.Fprint(w, `{"page": 1, "page_size": 20, "participants": [{"call_sid": "CA123", "conference_sid": "CF123", "muted": true}]}`)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	actual, err := v.ListParticipants("CF123", ParticipantsMuted(true), ParticipantsOnHold(false), ParticipantsPageSize(20), ParticipantsPage(1))
+	assert.NoError(t, err)
+	assert.Equal(t, &ParticipantList{
+		Page:         1,
+		PageSize:     20,
+		Participants: []*Participant{{CallSID: "CA123", ConferenceSID: "CF123", Muted: true}},
+	}, actual)
+}
+
+func TestUpdateParticipants(t *testing.T) {
+	tests := []struct {
+		name          string
+		call          func(v *VTwilio) (*Participant, error)
+		expectedPath  string
+		expectedForm  url.Values
+		expectedError error
+	}{
+		{
+			name:         "add",
+			call:         func(v *VTwilio) (*Participant, error) { return v.AddParticipant("CF123", "+12345678910", "") },
+			expectedPath: "/sid/Conferences/CF123/Participants.json",
+			expectedForm: url.Values{"To": {"+12345678910"}, "From": {"+10987654321"}},
+		},
+		{
+			name: "add with options",
+			call: func(v *VTwilio) (*Participant, error) {
+				return v.AddParticipant("CF123", "+12345678910", "+11234567890",
+					ParticipantLabel("agent"),
+					ParticipantMuted(),
+					ParticipantBeep(false),
+					ParticipantStartConferenceOnEnter(true),
+					ParticipantEndConferenceOnExit(false),
+					ParticipantCoaching("CA999"),
+					ParticipantStatusCallback("http://url.com/status", POST, ParticipantRinging, ParticipantAnswered),
+					ParticipantTimeout(30),
+					ParticipantRecord(),
+					ParticipantWaitURL("http://url.com/wait"))
+			},
+			expectedPath: "/sid/Conferences/CF123/Participants.json",
+			expectedForm: url.Values{
+				"To":                     {"+12345678910"},
+				"From":                   {"+11234567890"},
+				"Label":                  {"agent"},
+				"Muted":                  {"true"},
+				"Beep":                   {"false"},
+				"StartConferenceOnEnter": {"true"},
+				"EndConferenceOnExit":    {"false"},
+				"Coaching":               {"true"},
+				"CallSidToCoach":         {"CA999"},
+				"StatusCallback":         {"http://url.com/status"},
+				"StatusCallbackMethod":   {"POST"},
+				"StatusCallbackEvent":    {"ringing", "answered"},
+				"Timeout":                {"30"},
+				"Record":                 {"true"},
+				"WaitUrl":                {"http://url.com/wait"},
+			},
+		},
+		{
+			name:         "mute",
+			call:         func(v *VTwilio) (*Participant, error) { return v.MuteParticipant("CF123", "CA123", true) },
+			expectedPath: "/sid/Conferences/CF123/Participants/CA123.json",
+			expectedForm: url.Values{"Muted": {"true"}},
+		},
+		{
+			name: "hold with music",
+			call: func(v *VTwilio) (*Participant, error) {
+				return v.HoldParticipant("CF123", "CA123", true, "http://url.com/music.mp3")
+			},
+			expectedPath: "/sid/Conferences/CF123/Participants/CA123.json",
+			expectedForm: url.Values{"Hold": {"true"}, "HoldUrl": {"http://url.com/music.mp3"}},
+		},
+		{
+			name:         "take off hold",
+			call:         func(v *VTwilio) (*Participant, error) { return v.HoldParticipant("CF123", "CA123", false, "") },
+			expectedPath: "/sid/Conferences/CF123/Participants/CA123.json",
+			expectedForm: url.Values{"Hold": {"false"}},
+		},
+		{
+			name:         "coach",
+			call:         func(v *VTwilio) (*Participant, error) { return v.CoachParticipant("CF123", "CA123", "CA999") },
+			expectedPath: "/sid/Conferences/CF123/Participants/CA123.json",
+			expectedForm: url.Values{"Coaching": {"true"}, "CallSidToCoach": {"CA999"}},
+		},
+		{
+			name:         "stop coaching",
+			call:         func(v *VTwilio) (*Participant, error) { return v.CoachParticipant("CF123", "CA123", "") },
+			expectedPath: "/sid/Conferences/CF123/Participants/CA123.json",
+			expectedForm: url.Values{"Coaching": {"false"}},
+		},
+		{
+			name:          "invalid number",
+			call:          func(v *VTwilio) (*Participant, error) { return v.AddParticipant("CF123", "2345678910", "") },
+			expectedError: fmt.Errorf("phone number must begin with + or a default region must be set"),
+		},
+		{
+			name:          "no call sid",
+			call:          func(v *VTwilio) (*Participant, error) { return v.MuteParticipant("CF123", "", true) },
+			expectedError: fmt.Errorf("must contain a call SID"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read body: %v", err)
+				}
+				form, err := url.ParseQuery(string(body))
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedForm, form)
+				fmt.Fprint(w, `{"call_sid": "CA123", "conference_sid": "CF123"}`)
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{accountSID: "sid", authToken: "token", twilioNumber: "+10987654321", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+			actual, err := tt.call(v)
+			assert.Equal(t, tt.expectedError, err)
+			if err == nil {
+				assert.Equal(t, "CA123", actual.CallSID)
+			}
+		})
+	}
+}
+
+func TestRemoveParticipant(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/sid/Conferences/CF123/Participants/CA123.json", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	assert.NoError(t, v.RemoveParticipant("CF123", "CA123"))
+	assert.Equal(t, fmt.Errorf("must contain a conference SID"), v.RemoveParticipant("", "CA123"))
+}
@@ -0,0 +1,129 @@
+package phonenumber
+
+// territory is the numbering metadata for a country calling code
+type territory struct {
+	// regions sharing the calling code, the main region first
+	regions []string
+	// nationalPrefix is dialled before a national number inside the country
+	nationalPrefix string
+	// internationalPrefix is dialled before a country calling code to leave the country
+	internationalPrefix string
+	// lengths are the valid lengths of a national significant number
+	lengths []int
+	// groups is how a national number is split when formatted, it is only used
+	// when it adds up to the length of the number
+	groups [][]int
+}
+
+// territories is keyed by country calling code
+var territories = map[int]*territory{
+	1: {
+		regions: []string{"US", "CA", "AG", "AI", "AS", "BB", "BM", "BS", "DM", "DO", "GD", "GU", "JM",
+			"KN", "KY", "LC", "MP", "MS", "PR", "SX", "TC", "TT", "VC", "VG", "VI"},
+		nationalPrefix: "1", internationalPrefix: "011", lengths: []int{10},
+		groups: [][]int{{3, 3, 4}},
+	},
+	7:   {regions: []string{"RU", "KZ"}, nationalPrefix: "8", internationalPrefix: "810", lengths: []int{10}, groups: [][]int{{3, 3, 2, 2}}},
+	20:  {regions: []string{"EG"}, nationalPrefix: "0", lengths: []int{8, 9, 10}},
+	27:  {regions: []string{"ZA"}, nationalPrefix: "0", lengths: []int{9}, groups: [][]int{{2, 3, 4}}},
+	30:  {regions: []string{"GR"}, lengths: []int{10}},
+	31:  {regions: []string{"NL"}, nationalPrefix: "0", lengths: []int{9}, groups: [][]int{{1, 8}}},
+	32:  {regions: []string{"BE"}, nationalPrefix: "0", lengths: []int{8, 9}},
+	33:  {regions: []string{"FR"}, nationalPrefix: "0", lengths: []int{9}, groups: [][]int{{1, 2, 2, 2, 2}}},
+	34:  {regions: []string{"ES"}, lengths: []int{9}},
+	36:  {regions: []string{"HU"}, nationalPrefix: "06", lengths: []int{8, 9}},
+	39:  {regions: []string{"IT", "VA"}, lengths: []int{6, 7, 8, 9, 10, 11}},
+	40:  {regions: []string{"RO"}, nationalPrefix: "0", lengths: []int{9}},
+	41:  {regions: []string{"CH"}, nationalPrefix: "0", lengths: []int{9}, groups: [][]int{{2, 3, 2, 2}}},
+	43:  {regions: []string{"AT"}, nationalPrefix: "0", lengths: []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13}},
+	44:  {regions: []string{"GB", "GG", "IM", "JE"}, nationalPrefix: "0", lengths: []int{9, 10}, groups: [][]int{{4, 6}}},
+	45:  {regions: []string{"DK"}, lengths: []int{8}, groups: [][]int{{2, 2, 2, 2}}},
+	46:  {regions: []string{"SE"}, nationalPrefix: "0", lengths: []int{7, 8, 9, 10}},
+	47:  {regions: []string{"NO", "SJ"}, lengths: []int{5, 8}},
+	48:  {regions: []string{"PL"}, lengths: []int{9}},
+	49:  {regions: []string{"DE"}, nationalPrefix: "0", lengths: []int{6, 7, 8, 9, 10, 11, 12, 13}},
+	51:  {regions: []string{"PE"}, nationalPrefix: "0", lengths: []int{8, 9}},
+	52:  {regions: []string{"MX"}, lengths: []int{10}, groups: [][]int{{2, 4, 4}}},
+	53:  {regions: []string{"CU"}, nationalPrefix: "0", internationalPrefix: "119", lengths: []int{6, 7, 8}},
+	54:  {regions: []string{"AR"}, nationalPrefix: "0", lengths: []int{10, 11}},
+	55:  {regions: []string{"BR"}, nationalPrefix: "0", lengths: []int{10, 11}, groups: [][]int{{2, 4, 4}, {2, 5, 4}}},
+	56:  {regions: []string{"CL"}, lengths: []int{9}},
+	57:  {regions: []string{"CO"}, lengths: []int{8, 10}},
+	58:  {regions: []string{"VE"}, nationalPrefix: "0", lengths: []int{10}},
+	60:  {regions: []string{"MY"}, nationalPrefix: "0", lengths: []int{8, 9, 10}},
+	61:  {regions: []string{"AU", "CC", "CX"}, nationalPrefix: "0", internationalPrefix: "0011", lengths: []int{9}},
+	62:  {regions: []string{"ID"}, nationalPrefix: "0", lengths: []int{8, 9, 10, 11, 12}},
+	63:  {regions: []string{"PH"}, nationalPrefix: "0", lengths: []int{8, 9, 10}},
+	64:  {regions: []string{"NZ"}, nationalPrefix: "0", lengths: []int{8, 9, 10}},
+	65:  {regions: []string{"SG"}, lengths: []int{8}, groups: [][]int{{4, 4}}},
+	66:  {regions: []string{"TH"}, nationalPrefix: "0", lengths: []int{8, 9}},
+	81:  {regions: []string{"JP"}, nationalPrefix: "0", internationalPrefix: "010", lengths: []int{9, 10}},
+	82:  {regions: []string{"KR"}, nationalPrefix: "0", internationalPrefix: "001", lengths: []int{8, 9, 10}},
+	84:  {regions: []string{"VN"}, nationalPrefix: "0", lengths: []int{9, 10}},
+	86:  {regions: []string{"CN"}, nationalPrefix: "0", lengths: []int{10, 11}, groups: [][]int{{3, 4, 4}}},
+	90:  {regions: []string{"TR"}, nationalPrefix: "0", lengths: []int{10}},
+	91:  {regions: []string{"IN"}, nationalPrefix: "0", lengths: []int{10}, groups: [][]int{{5, 5}}},
+	92:  {regions: []string{"PK"}, nationalPrefix: "0", lengths: []int{9, 10}},
+	93:  {regions: []string{"AF"}, nationalPrefix: "0", lengths: []int{9}},
+	94:  {regions: []string{"LK"}, nationalPrefix: "0", lengths: []int{9}},
+	95:  {regions: []string{"MM"}, nationalPrefix: "0", lengths: []int{7, 8, 9, 10}},
+	98:  {regions: []string{"IR"}, nationalPrefix: "0", lengths: []int{10}},
+	212: {regions: []string{"MA", "EH"}, nationalPrefix: "0", lengths: []int{9}},
+	213: {regions: []string{"DZ"}, nationalPrefix: "0", lengths: []int{8, 9}},
+	216: {regions: []string{"TN"}, lengths: []int{8}},
+	233: {regions: []string{"GH"}, nationalPrefix: "0", lengths: []int{9}},
+	234: {regions: []string{"NG"}, nationalPrefix: "0", internationalPrefix: "009", lengths: []int{8, 10}},
+	254: {regions: []string{"KE"}, nationalPrefix: "0", internationalPrefix: "000", lengths: []int{9, 10}},
+	255: {regions: []string{"TZ"}, nationalPrefix: "0", internationalPrefix: "000", lengths: []int{9}},
+	256: {regions: []string{"UG"}, nationalPrefix: "0", internationalPrefix: "000", lengths: []int{9}},
+	351: {regions: []string{"PT"}, lengths: []int{9}},
+	352: {regions: []string{"LU"}, lengths: []int{4, 5, 6, 7, 8, 9, 10, 11}},
+	353: {regions: []string{"IE"}, nationalPrefix: "0", lengths: []int{7, 8, 9}},
+	354: {regions: []string{"IS"}, lengths: []int{7, 9}},
+	356: {regions: []string{"MT"}, lengths: []int{8}},
+	357: {regions: []string{"CY"}, lengths: []int{8}},
+	358: {regions: []string{"FI", "AX"}, nationalPrefix: "0", lengths: []int{5, 6, 7, 8, 9, 10, 11, 12}},
+	359: {regions: []string{"BG"}, nationalPrefix: "0", lengths: []int{7, 8, 9}},
+	370: {regions: []string{"LT"}, nationalPrefix: "8", lengths: []int{8}},
+	371: {regions: []string{"LV"}, lengths: []int{8}},
+	372: {regions: []string{"EE"}, lengths: []int{7, 8}},
+	380: {regions: []string{"UA"}, nationalPrefix: "0", lengths: []int{9}},
+	381: {regions: []string{"RS"}, nationalPrefix: "0", lengths: []int{6, 7, 8, 9, 10, 11, 12}},
+	385: {regions: []string{"HR"}, nationalPrefix: "0", lengths: []int{8, 9}},
+	386: {regions: []string{"SI"}, nationalPrefix: "0", lengths: []int{8}},
+	420: {regions: []string{"CZ"}, lengths: []int{9}},
+	421: {regions: []string{"SK"}, nationalPrefix: "0", lengths: []int{9}},
+	502: {regions: []string{"GT"}, lengths: []int{8}},
+	503: {regions: []string{"SV"}, lengths: []int{8}},
+	504: {regions: []string{"HN"}, lengths: []int{8}},
+	505: {regions: []string{"NI"}, lengths: []int{8}},
+	506: {regions: []string{"CR"}, lengths: []int{8}},
+	507: {regions: []string{"PA"}, lengths: []int{7, 8}},
+	591: {regions: []string{"BO"}, nationalPrefix: "0", lengths: []int{8}},
+	593: {regions: []string{"EC"}, nationalPrefix: "0", lengths: []int{8, 9}},
+	595: {regions: []string{"PY"}, nationalPrefix: "0", lengths: []int{9}},
+	598: {regions: []string{"UY"}, nationalPrefix: "0", lengths: []int{8}},
+	852: {regions: []string{"HK"}, internationalPrefix: "001", lengths: []int{8}, groups: [][]int{{4, 4}}},
+	853: {regions: []string{"MO"}, lengths: []int{8}},
+	880: {regions: []string{"BD"}, nationalPrefix: "0", lengths: []int{10}},
+	886: {regions: []string{"TW"}, nationalPrefix: "0", lengths: []int{8, 9}},
+	965: {regions: []string{"KW"}, lengths: []int{8}},
+	966: {regions: []string{"SA"}, nationalPrefix: "0", lengths: []int{9}},
+	971: {regions: []string{"AE"}, nationalPrefix: "0", lengths: []int{8, 9}},
+	972: {regions: []string{"IL"}, nationalPrefix: "0", internationalPrefix: "01", lengths: []int{8, 9}},
+	974: {regions: []string{"QA"}, lengths: []int{8}},
+}
+
+// regionCodes maps a region to its country calling code, it is built from territories on init
+var regionCodes = map[string]int{}
+
+func init() {
+	for cc, t := range territories {
+		if t.internationalPrefix == "" {
+			t.internationalPrefix = "00"
+		}
+		for _, r := range t.regions {
+			regionCodes[r] = cc
+		}
+	}
+}
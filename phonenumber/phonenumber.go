@@ -0,0 +1,232 @@
+// Package phonenumber parses, validates and formats phone numbers using offline metadata
+package phonenumber
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxDigits is the longest an E.164 number can be, not counting the +
+const maxDigits = 15
+
+// Format is a way of writing a phone number
+type Format int
+
+const (
+	// E164 format e.g. +14155551234
+	E164 Format = iota
+	// International format e.g. +1 415-555-1234
+	International
+	// National format e.g. (415) 555-1234
+	National
+)
+
+// PhoneNumber is a parsed phone number
+type PhoneNumber struct {
+	CountryCode    int
+	NationalNumber string
+	Region         string
+}
+
+// Parse parses a phone number. Numbers starting with + are parsed as international numbers,
+// any other number is parsed as a number dialled from defaultRegion e.g. "(415) 555-1234" with "US".
+// Spaces, dashes, dots, slashes and brackets are ignored.
+func Parse(number, defaultRegion string) (*PhoneNumber, error) {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return nil, fmt.Errorf("phone number is required")
+	}
+
+	international := strings.HasPrefix(number, "+")
+	if international {
+		number = number[1:]
+	}
+	digits, err := stripPunctuation(number)
+	if err != nil {
+		return nil, err
+	}
+	if digits == "" {
+		return nil, fmt.Errorf("phone number must be numbers")
+	}
+
+	var home *territory
+	if !international {
+		if defaultRegion == "" {
+			return nil, fmt.Errorf("phone number must begin with + or a default region must be set")
+		}
+		cc, ok := regionCodes[strings.ToUpper(defaultRegion)]
+		if !ok {
+			return nil, fmt.Errorf("unknown region %v", defaultRegion)
+		}
+		home = territories[cc]
+		if strings.HasPrefix(digits, home.internationalPrefix) {
+			digits = strings.TrimPrefix(digits, home.internationalPrefix)
+			international = true
+		}
+	}
+
+	p := &PhoneNumber{}
+	if international {
+		if len(digits) > maxDigits {
+			return nil, fmt.Errorf("number can only contain %v digits", maxDigits)
+		}
+		cc, national, ok := splitCountryCode(digits)
+		if !ok {
+			return nil, fmt.Errorf("invalid country calling code")
+		}
+		p.CountryCode = cc
+		p.NationalNumber = national
+	} else {
+		p.CountryCode = regionCodes[strings.ToUpper(defaultRegion)]
+		p.NationalNumber = stripNationalPrefix(digits, home)
+	}
+
+	t := territories[p.CountryCode]
+	if !validLength(len(p.NationalNumber), t.lengths) {
+		return nil, fmt.Errorf("invalid length for a +%v phone number", p.CountryCode)
+	}
+	if len(strconv.Itoa(p.CountryCode))+len(p.NationalNumber) > maxDigits {
+		return nil, fmt.Errorf("number can only contain %v digits", maxDigits)
+	}
+
+	p.Region = t.regions[0]
+	if cc, ok := regionCodes[strings.ToUpper(defaultRegion)]; ok && cc == p.CountryCode {
+		p.Region = strings.ToUpper(defaultRegion)
+	}
+	return p, nil
+}
+
+// Normalize parses a phone number and returns it in E.164 format
+func Normalize(number, defaultRegion string) (string, error) {
+	p, err := Parse(number, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+	return p.Format(E164), nil
+}
+
+// IsValid reports whether a phone number can be parsed
+func IsValid(number, defaultRegion string) bool {
+	_, err := Parse(number, defaultRegion)
+	return err == nil
+}
+
+// CountryCodeForRegion returns the country calling code for a region e.g. 44 for "GB"
+func CountryCodeForRegion(region string) (int, bool) {
+	cc, ok := regionCodes[strings.ToUpper(region)]
+	return cc, ok
+}
+
+// RegionsForCountryCode returns the regions that share a country calling code, the main region first
+func RegionsForCountryCode(cc int) []string {
+	t, ok := territories[cc]
+	if !ok {
+		return nil
+	}
+	return append([]string{}, t.regions...)
+}
+
+// String returns the number in E.164 format
+func (p *PhoneNumber) String() string {
+	return p.Format(E164)
+}
+
+// Format writes the number in the given format
+func (p *PhoneNumber) Format(f Format) string {
+	t := territories[p.CountryCode]
+	switch f {
+	case International:
+		sep := " "
+		if p.CountryCode == 1 {
+			sep = "-"
+		}
+		return fmt.Sprintf("+%v %v", p.CountryCode, strings.Join(group(p.NationalNumber, t.groups), sep))
+	case National:
+		parts := group(p.NationalNumber, t.groups)
+		if p.CountryCode == 1 && len(parts) == 3 {
+			return fmt.Sprintf("(%v) %v-%v", parts[0], parts[1], parts[2])
+		}
+		return t.nationalPrefix + strings.Join(parts, " ")
+	default:
+		return fmt.Sprintf("+%v%v", p.CountryCode, p.NationalNumber)
+	}
+}
+
+func stripPunctuation(n string) (string, error) {
+	var b strings.Builder
+	for _, r := range n {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '/' || r == '(' || r == ')':
+		default:
+			return "", fmt.Errorf("phone number contains invalid character %q", r)
+		}
+	}
+	return b.String(), nil
+}
+
+func splitCountryCode(digits string) (int, string, bool) {
+	for i := 1; i <= 3 && i < len(digits); i++ {
+		cc, err := strconv.Atoi(digits[:i])
+		if err != nil {
+			return 0, "", false
+		}
+		if _, ok := territories[cc]; ok {
+			return cc, digits[i:], true
+		}
+	}
+	return 0, "", false
+}
+
+func stripNationalPrefix(digits string, t *territory) string {
+	if t.nationalPrefix == "" || !strings.HasPrefix(digits, t.nationalPrefix) {
+		return digits
+	}
+	national := strings.TrimPrefix(digits, t.nationalPrefix)
+	if validLength(len(digits), t.lengths) && !validLength(len(national), t.lengths) {
+		return digits
+	}
+	return national
+}
+
+func validLength(n int, lengths []int) bool {
+	for _, l := range lengths {
+		if n == l {
+			return true
+		}
+	}
+	return false
+}
+
+// group splits a national number using the first matching pattern, numbers
+// without a pattern are split into blocks of three with a trailing block of four
+func group(n string, patterns [][]int) []string {
+	for _, p := range patterns {
+		total := 0
+		for _, g := range p {
+			total += g
+		}
+		if total != len(n) {
+			continue
+		}
+		parts := make([]string, 0, len(p))
+		for _, g := range p {
+			parts = append(parts, n[:g])
+			n = n[g:]
+		}
+		return parts
+	}
+
+	parts := []string{}
+	for len(n) > 4 {
+		size := 3
+		if len(n) == 8 {
+			size = 4
+		}
+		parts = append(parts, n[:size])
+		n = n[size:]
+	}
+	return append(parts, n)
+}
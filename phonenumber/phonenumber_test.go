@@ -0,0 +1,156 @@
+package phonenumber_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twiebe-va/vtwilio-go/phonenumber"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		number        string
+		region        string
+		expected      *phonenumber.PhoneNumber
+		expectedError error
+	}{
+		{
+			name:     "e164",
+			number:   "+14155551234",
+			expected: &phonenumber.PhoneNumber{CountryCode: 1, NationalNumber: "4155551234", Region: "US"},
+		},
+		{
+			name:     "national with punctuation",
+			number:   "(415) 555-1234",
+			region:   "US",
+			expected: &phonenumber.PhoneNumber{CountryCode: 1, NationalNumber: "4155551234", Region: "US"},
+		},
+		{
+			name:     "national with trunk prefix",
+			number:   "1-415-555-1234",
+			region:   "US",
+			expected: &phonenumber.PhoneNumber{CountryCode: 1, NationalNumber: "4155551234", Region: "US"},
+		},
+		{
+			name:     "shared country code keeps default region",
+			number:   "604.555.1234",
+			region:   "ca",
+			expected: &phonenumber.PhoneNumber{CountryCode: 1, NationalNumber: "6045551234", Region: "CA"},
+		},
+		{
+			name:     "national prefix is removed",
+			number:   "07700 900123",
+			region:   "GB",
+			expected: &phonenumber.PhoneNumber{CountryCode: 44, NationalNumber: "7700900123", Region: "GB"},
+		},
+		{
+			name:     "international prefix from default region",
+			number:   "011 44 7700 900123",
+			region:   "US",
+			expected: &phonenumber.PhoneNumber{CountryCode: 44, NationalNumber: "7700900123", Region: "GB"},
+		},
+		{
+			name:     "three digit country code",
+			number:   "+353 86 123 4567",
+			expected: &phonenumber.PhoneNumber{CountryCode: 353, NationalNumber: "861234567", Region: "IE"},
+		},
+		{
+			name:          "empty",
+			number:        "",
+			expectedError: fmt.Errorf("phone number is required"),
+		},
+		{
+			name:          "no plus or region",
+			number:        "4155551234",
+			expectedError: fmt.Errorf("phone number must begin with + or a default region must be set"),
+		},
+		{
+			name:          "letters",
+			number:        "+1415CALLNOW",
+			expectedError: fmt.Errorf("phone number contains invalid character 'C'"),
+		},
+		{
+			name:          "unknown region",
+			number:        "4155551234",
+			region:        "XX",
+			expectedError: fmt.Errorf("unknown region XX"),
+		},
+		{
+			name:          "unknown country code",
+			number:        "+9991234567",
+			expectedError: fmt.Errorf("invalid country calling code"),
+		},
+		{
+			name:          "wrong length",
+			number:        "+1415555123",
+			expectedError: fmt.Errorf("invalid length for a +1 phone number"),
+		},
+		{
+			name:          "too long",
+			number:        "+1234567891015141",
+			expectedError: fmt.Errorf("number can only contain 15 digits"),
+		},
+		{
+			name:          "only a plus",
+			number:        "+",
+			expectedError: fmt.Errorf("phone number must be numbers"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := phonenumber.Parse(tt.number, tt.region)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   string
+		format   phonenumber.Format
+		expected string
+	}{
+		{"us e164", "+14155551234", phonenumber.E164, "+14155551234"},
+		{"us international", "+14155551234", phonenumber.International, "+1 415-555-1234"},
+		{"us national", "+14155551234", phonenumber.National, "(415) 555-1234"},
+		{"gb international", "+447700900123", phonenumber.International, "+44 7700 900123"},
+		{"gb national", "+447700900123", phonenumber.National, "07700 900123"},
+		{"fr national", "+33612345678", phonenumber.National, "06 12 34 56 78"},
+		{"default grouping", "+34912345678", phonenumber.International, "+34 912 345 678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := phonenumber.Parse(tt.number, "")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, p.Format(tt.format))
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	actual, err := phonenumber.Normalize("(555) 123-4567", "US")
+	assert.NoError(t, err)
+	assert.Equal(t, "+15551234567", actual)
+
+	_, err = phonenumber.Normalize("555-1234", "US")
+	assert.Error(t, err)
+}
+
+func TestRegions(t *testing.T) {
+	cc, ok := phonenumber.CountryCodeForRegion("gb")
+	assert.True(t, ok)
+	assert.Equal(t, 44, cc)
+
+	_, ok = phonenumber.CountryCodeForRegion("XX")
+	assert.False(t, ok)
+
+	assert.Equal(t, "US", phonenumber.RegionsForCountryCode(1)[0])
+	assert.Nil(t, phonenumber.RegionsForCountryCode(999))
+	assert.True(t, phonenumber.IsValid("+447700900123", ""))
+}
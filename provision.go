@@ -0,0 +1,155 @@
+package vtwilio
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ProvisionStepKind is a step of provisioning a number
+type ProvisionStepKind string
+
+const (
+	// SearchStep searches for available numbers
+	SearchStep ProvisionStepKind = "search"
+	// PurchaseStep buys a candidate number
+	PurchaseStep ProvisionStepKind = "purchase"
+	// ConfigureStep applies the SMS and voice configuration to the bought number
+	ConfigureStep ProvisionStepKind = "configure"
+	// ReleaseStep releases the bought number after a later step failed
+	ReleaseStep ProvisionStepKind = "release"
+)
+
+// defaultMaxCandidates is how many numbers ProvisionNumber tries to buy before giving up
+const defaultMaxCandidates = 5
+
+// NumberCriteria is the number ProvisionNumber searches for
+type NumberCriteria struct {
+	// CountryCode is the ISO country to search e.g. "US"
+	CountryCode string
+	// Options filter the search, see AvailablePhoneNumbers
+	Options []AvailableOption
+	// MaxCandidates is how many numbers to try to buy, defaults to 5
+	MaxCandidates int
+}
+
+// ProvisionStep is the outcome of one step of provisioning a number
+type ProvisionStep struct {
+	Kind     ProvisionStepKind
+	Number   string
+	SID      string
+	Duration time.Duration
+	Err      error
+}
+
+// ProvisionReport describes everything ProvisionNumber did
+type ProvisionReport struct {
+	// Number is the provisioned number, nil when provisioning failed
+	Number *IncomingPhoneNumber
+	Steps  []ProvisionStep
+	// RolledBack is true when a bought number was released because a later step failed
+	RolledBack bool
+}
+
+func (r *ProvisionReport) record(kind ProvisionStepKind, number, sid string, started time.Time, err error) {
+	r.Steps = append(r.Steps, ProvisionStep{
+		Kind:     kind,
+		Number:   number,
+		SID:      sid,
+		Duration: time.Since(started),
+		Err:      err,
+	})
+}
+
+// ProvisionNumber searches for a number, buys the first candidate Twilio lets it buy and applies config to it.
+// ctx is passed to each request. If configuring the number fails, or ctx is done before it finishes, the number
+// is released so it is not left on the account. A purchase cut short by ctx may still have gone through, so the
+// candidate is looked up and released if the account owns it. The report is always returned, even with an error.
+func (v *VTwilio) ProvisionNumber(ctx context.Context, criteria NumberCriteria, config ...IncomingPhoneNumberOption) (*ProvisionReport, error) {
+	report := &ProvisionReport{}
+	if criteria.MaxCandidates <= 0 {
+		criteria.MaxCandidates = defaultMaxCandidates
+	}
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+
+	started := time.Now()
+	available, err := v.availablePhoneNumbers(ctx, criteria.CountryCode, criteria.Options...)
+	report.record(SearchStep, "", "", started, err)
+	if err != nil {
+		return report, err
+	}
+	if len(available.AvailablePhoneNumber) == 0 {
+		return report, fmt.Errorf("no numbers available in %v", criteria.CountryCode)
+	}
+
+	var bought *IncomingPhoneNumber
+	candidates := available.AvailablePhoneNumber
+	if len(candidates) > criteria.MaxCandidates {
+		candidates = candidates[:criteria.MaxCandidates]
+	}
+	for _, c := range candidates {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		started = time.Now()
+		bought, err = v.incomingPhoneNumber(ctx, c.PhoneNumber, "")
+		if err != nil {
+			report.record(PurchaseStep, c.PhoneNumber, "", started, err)
+			if ctx.Err() != nil {
+				return report, v.recoverPurchase(report, c.PhoneNumber, err)
+			}
+			continue
+		}
+		report.record(PurchaseStep, bought.PhoneNumber, bought.SID, started, nil)
+		break
+	}
+	if bought == nil {
+		return report, fmt.Errorf("unable to purchase any of %v candidate numbers", len(candidates))
+	}
+
+	err = ctx.Err()
+	if err == nil && len(config) > 0 {
+		started = time.Now()
+		var configured *IncomingPhoneNumber
+		configured, err = v.incomingPhoneNumber(ctx, bought.PhoneNumber, bought.SID, config...)
+		report.record(ConfigureStep, bought.PhoneNumber, bought.SID, started, err)
+		if err == nil {
+			bought = configured
+		}
+	}
+	if err != nil {
+		return report, v.rollbackProvision(report, bought, err)
+	}
+
+	report.Number = bought
+	return report, nil
+}
+
+// recoverPurchase is called when ctx cut a purchase short, Twilio may have bought the number before the
+// request was cancelled so it is released if the account owns it
+func (v *VTwilio) recoverPurchase(report *ProvisionReport, number string, cause error) error {
+	owned, err := v.ListIncomingPhoneNumbers(IncomingNumbersMatching(number))
+	if err != nil {
+		return fmt.Errorf("%v: unable to check whether %v was bought, it may be on the account: %v", cause, number, err)
+	}
+	for _, n := range owned.IncomingPhoneNumbers {
+		if n.PhoneNumber == number {
+			return v.rollbackProvision(report, n, cause)
+		}
+	}
+	return cause
+}
+
+// rollbackProvision releases a bought number and returns the error that caused the rollback
+func (v *VTwilio) rollbackProvision(report *ProvisionReport, bought *IncomingPhoneNumber, cause error) error {
+	started := time.Now()
+	err := v.ReleaseNumber(bought.SID, OverrideProtection())
+	report.record(ReleaseStep, bought.PhoneNumber, bought.SID, started, err)
+	if err != nil {
+		return fmt.Errorf("%v: releasing %v (%v) also failed, it is still on the account: %v", cause, bought.PhoneNumber, bought.SID, err)
+	}
+	report.RolledBack = true
+	return cause
+}
@@ -0,0 +1,168 @@
+package vtwilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// provisionServer fakes the Twilio number endpoints, purchases of numbers in taken fail
+// and configuring fails when failConfigure is set
+func provisionServer(taken map[string]bool, failConfigure bool, released *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET":
+			fmt.Fprint(w, `{"available_phone_numbers": [{"phone_number": "+13065550001"}, {"phone_number": "+13065550002"}, {"phone_number": "+13065550003"}]}`)
+		case r.Method == "DELETE":
+			*released = append(*released, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/sid/IncomingPhoneNumbers.json":
+			number := r.FormValue("PhoneNumber")
+			if taken[number] {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"status": 400, "message": "number is not available"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"sid": "PN%v", "phone_number": %q}`, number[len(number)-1:], number)
+		default:
+			if failConfigure {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"status": 400, "message": "invalid sms url"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"sid": "PN2", "phone_number": "+13065550002", "sms_url": %q}`, r.FormValue("SmsUrl"))
+		}
+	}))
+}
+
+func TestProvisionNumber(t *testing.T) {
+	var released []string
+	ts := provisionServer(map[string]bool{"+13065550001": true}, false, &released)
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	report, err := v.ProvisionNumber(context.Background(), NumberCriteria{CountryCode: "CA"}, SMSURL("http://url.com/sms"))
+	assert.NoError(t, err)
+	assert.Equal(t, "PN2", report.Number.SID)
+	assert.Equal(t, "http://url.com/sms", report.Number.SMSURL)
+	assert.False(t, report.RolledBack)
+	assert.Empty(t, released)
+
+	kinds := []ProvisionStepKind{}
+	for _, s := range report.Steps {
+		kinds = append(kinds, s.Kind)
+	}
+	assert.Equal(t, []ProvisionStepKind{SearchStep, PurchaseStep, PurchaseStep, ConfigureStep}, kinds)
+	assert.Error(t, report.Steps[1].Err)
+	assert.Equal(t, "+13065550001", report.Steps[1].Number)
+	assert.NoError(t, report.Steps[2].Err)
+}
+
+func TestProvisionNumberRollback(t *testing.T) {
+	var released []string
+	ts := provisionServer(map[string]bool{}, true, &released)
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	report, err := v.ProvisionNumber(context.Background(), NumberCriteria{CountryCode: "CA"}, SMSURL("bad"))
+	assert.Error(t, err)
+	assert.Nil(t, report.Number)
+	assert.True(t, report.RolledBack)
+	assert.Equal(t, []string{"/sid/IncomingPhoneNumbers/PN1.json"}, released)
+	assert.Equal(t, ReleaseStep, report.Steps[len(report.Steps)-1].Kind)
+}
+
+func TestProvisionNumberNoCandidates(t *testing.T) {
+	var released []string
+	taken := map[string]bool{"+13065550001": true, "+13065550002": true, "+13065550003": true}
+	ts := provisionServer(taken, false, &released)
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	report, err := v.ProvisionNumber(context.Background(), NumberCriteria{CountryCode: "CA", MaxCandidates: 2})
+	assert.Equal(t, fmt.Errorf("unable to purchase any of 2 candidate numbers"), err)
+	assert.Len(t, report.Steps, 3)
+	assert.Empty(t, released)
+}
+
+func TestProvisionNumberCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: "http://192.168.0.%31/"}
+	report, err := v.ProvisionNumber(ctx, NumberCriteria{CountryCode: "CA"})
+	assert.Equal(t, context.Canceled, err)
+	assert.Empty(t, report.Steps)
+}
+
+func TestProvisionNumberCancelledInFlight(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var released []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET":
+			fmt.Fprint(w, `{"available_phone_numbers": [{"phone_number": "+13065550001"}]}`)
+		case r.Method == "DELETE":
+			released = append(released, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/sid/IncomingPhoneNumbers.json":
+			fmt.Fprint(w, `{"sid": "PN1", "phone_number": "+13065550001"}`)
+		default:
+			// the configure request is still in flight when ctx is cancelled, the body is read so the
+			// server notices the client closing the connection
+			r.ParseForm()
+			cancel()
+			<-r.Context().Done()
+		}
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	report, err := v.ProvisionNumber(ctx, NumberCriteria{CountryCode: "CA"}, SMSURL("http://url.com/sms"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+	assert.True(t, report.RolledBack)
+	assert.Equal(t, []string{"/sid/IncomingPhoneNumbers/PN1.json"}, released)
+}
+
+func TestProvisionNumberCancelledAfterPurchase(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	purchases := 0
+	var released []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/sid/IncomingPhoneNumbers.json":
+			assert.Equal(t, "+13065550001", r.URL.Query().Get("PhoneNumber"))
+			fmt.Fprint(w, `{"incoming_phone_numbers": [{"sid": "PN1", "phone_number": "+13065550001"}]}`)
+		case r.Method == "GET":
+			fmt.Fprint(w, `{"available_phone_numbers": [{"phone_number": "+13065550001"}, {"phone_number": "+13065550002"}]}`)
+		case r.Method == "DELETE":
+			released = append(released, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			// Twilio buys the number but the client gives up before the response arrives
+			r.ParseForm()
+			purchases++
+			cancel()
+			<-r.Context().Done()
+		}
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	report, err := v.ProvisionNumber(ctx, NumberCriteria{CountryCode: "CA"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+	assert.Equal(t, 1, purchases)
+	assert.True(t, report.RolledBack)
+	assert.Equal(t, []string{"/sid/IncomingPhoneNumbers/PN1.json"}, released)
+	assert.Equal(t, ReleaseStep, report.Steps[len(report.Steps)-1].Kind)
+}
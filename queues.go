@@ -0,0 +1,280 @@
+package vtwilio
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FrontOfQueue can be used in place of a call sid to dequeue the member that has waited the longest
+const FrontOfQueue = "Front"
+
+// Queue is a call queue from Twilio
+type Queue struct {
+	SID             string        `json:"sid"`
+	AccountSID      string        `json:"account_sid"`
+	FriendlyName    string        `json:"friendly_name"`
+	CurrentSize     int           `json:"current_size"`
+	MaxSize         int           `json:"max_size"`
+	AverageWaitTime time.Duration `json:"-"`
+	DateCreated     string        `json:"date_created"`
+	DateUpdated     string        `json:"date_updated"`
+	URI             string        `json:"uri"`
+}
+
+// UnmarshalJSON parses the queue's average wait time
+func (q *Queue) UnmarshalJSON(b []byte) error {
+	type queue Queue
+	aux := struct {
+		*queue
+		AverageWaitTime int `json:"average_wait_time"`
+	}{queue: (*queue)(q)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	q.AverageWaitTime = time.Duration(aux.AverageWaitTime) * time.Second
+	return nil
+}
+
+// MarshalJSON writes the queue in the format Twilio uses
+func (q Queue) MarshalJSON() ([]byte, error) {
+	type queue Queue
+	return json.Marshal(struct {
+		queue
+		AverageWaitTime int `json:"average_wait_time"`
+	}{queue: queue(q), AverageWaitTime: int(q.AverageWaitTime / time.Second)})
+}
+
+// QueueList is a page of queues
+type QueueList struct {
+	FirstPageURI    string   `json:"first_page_uri"`
+	NextPageURI     string   `json:"next_page_uri"`
+	PreviousPageURI string   `json:"previous_page_uri"`
+	Page            int      `json:"page"`
+	PageSize        int      `json:"page_size"`
+	End             int      `json:"end"`
+	Queues          []*Queue `json:"queues"`
+}
+
+// QueueMember is a call waiting in a queue
+type QueueMember struct {
+	CallSID      string        `json:"call_sid"`
+	QueueSID     string        `json:"queue_sid"`
+	Position     int           `json:"position"`
+	DateEnqueued time.Time     `json:"-"`
+	WaitTime     time.Duration `json:"-"`
+	URI          string        `json:"uri"`
+}
+
+// memberTimes are the queue member fields that are parsed into typed fields
+type memberTimes struct {
+	DateEnqueued string `json:"date_enqueued"`
+	WaitTime     int    `json:"wait_time"`
+}
+
+// UnmarshalJSON parses the member's enqueue date and wait time
+func (m *QueueMember) UnmarshalJSON(b []byte) error {
+	type member QueueMember
+	aux := struct {
+		*member
+		memberTimes
+	}{member: (*member)(m)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	if aux.memberTimes.DateEnqueued != "" {
+		t, err := ToTime(aux.memberTimes.DateEnqueued)
+		if err != nil {
+			return err
+		}
+		m.DateEnqueued = t
+	}
+	m.WaitTime = time.Duration(aux.memberTimes.WaitTime) * time.Second
+	return nil
+}
+
+// MarshalJSON writes the member in the format Twilio uses
+func (m QueueMember) MarshalJSON() ([]byte, error) {
+	type member QueueMember
+	aux := struct {
+		member
+		memberTimes
+	}{member: member(m)}
+	if !m.DateEnqueued.IsZero() {
+		aux.memberTimes.DateEnqueued = m.DateEnqueued.Format(twilioTimeFormat)
+	}
+	aux.memberTimes.WaitTime = int(m.WaitTime / time.Second)
+	return json.Marshal(aux)
+}
+
+// QueueMemberList is a page of queue members
+type QueueMemberList struct {
+	FirstPageURI    string         `json:"first_page_uri"`
+	NextPageURI     string         `json:"next_page_uri"`
+	PreviousPageURI string         `json:"previous_page_uri"`
+	Page            int            `json:"page"`
+	PageSize        int            `json:"page_size"`
+	End             int            `json:"end"`
+	Members         []*QueueMember `json:"queue_members"`
+}
+
+// CreateQueue creates a call queue
+func (v *VTwilio) CreateQueue(friendlyName string, opts ...QueueOption) (*Queue, error) {
+	if friendlyName == "" {
+		return nil, fmt.Errorf("queue must have a friendly name")
+	}
+	opts = append([]QueueOption{QueueFriendlyName(friendlyName)}, opts...)
+	urlStr := fmt.Sprintf("%s%s%s.json", v.baseAPI, v.accountSID, queuesAPI)
+	return v.postQueue(urlStr, opts)
+}
+
+// UpdateQueue changes a queue's name or max size
+func (v *VTwilio) UpdateQueue(sid string, opts ...QueueOption) (*Queue, error) {
+	if sid == "" {
+		return nil, fmt.Errorf("must contain a queue SID")
+	}
+	return v.postQueue(v.queueURL(sid, ""), opts)
+}
+
+// GetQueue gets a queue by its sid
+func (v *VTwilio) GetQueue(sid string) (*Queue, error) {
+	if sid == "" {
+		return nil, fmt.Errorf("must contain a queue SID")
+	}
+	req, err := http.NewRequest("GET", v.queueURL(sid, ""), nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleQueue(req)
+}
+
+// DeleteQueue deletes a queue, Twilio only deletes empty queues
+func (v *VTwilio) DeleteQueue(sid string) error {
+	if sid == "" {
+		return fmt.Errorf("must contain a queue SID")
+	}
+	req, err := http.NewRequest("DELETE", v.queueURL(sid, ""), nil)
+	if err != nil {
+		return err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return genericHandler(req)
+}
+
+// ListQueues returns a page of queues
+func (v *VTwilio) ListQueues(opts ...QueueListOption) (*QueueList, error) {
+	urlStr := fmt.Sprintf("%s%s%s.json?%s", v.baseAPI, v.accountSID, queuesAPI, queueListQuery(opts))
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleListQueues(req)
+}
+
+// ListQueueMembers returns a page of the calls waiting in a queue, front of the queue first
+func (v *VTwilio) ListQueueMembers(queueSID string, opts ...QueueListOption) (*QueueMemberList, error) {
+	if queueSID == "" {
+		return nil, fmt.Errorf("must contain a queue SID")
+	}
+	urlStr := fmt.Sprintf("%s?%s", v.queueURL(queueSID, membersAPI), queueListQuery(opts))
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleListQueueMembers(req)
+}
+
+// GetQueueMember gets a call waiting in a queue, use FrontOfQueue as the call sid for the longest waiting call
+func (v *VTwilio) GetQueueMember(queueSID, callSID string) (*QueueMember, error) {
+	if queueSID == "" {
+		return nil, fmt.Errorf("must contain a queue SID")
+	}
+	if callSID == "" {
+		return nil, fmt.Errorf("must contain a call SID")
+	}
+	req, err := http.NewRequest("GET", v.queueURL(queueSID, membersAPI+"/"+callSID), nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleQueueMember(req)
+}
+
+// DequeueMember removes a call from a queue and redirects it to the TwiML at redirectURL
+func (v *VTwilio) DequeueMember(queueSID, callSID, redirectURL string, method Method) (*QueueMember, error) {
+	if queueSID == "" {
+		return nil, fmt.Errorf("must contain a queue SID")
+	}
+	if callSID == "" {
+		return nil, fmt.Errorf("must contain a call SID")
+	}
+	if redirectURL == "" {
+		return nil, fmt.Errorf("must contain a url to redirect the call to")
+	}
+
+	values := url.Values{"Url": {redirectURL}}
+	if method != "" {
+		values.Set("Method", method.String())
+	}
+	req, err := http.NewRequest("POST", v.queueURL(queueSID, membersAPI+"/"+callSID), strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleQueueMember(req)
+}
+
+// DequeueFront removes the longest waiting call from a queue and redirects it to the TwiML at redirectURL
+func (v *VTwilio) DequeueFront(queueSID, redirectURL string, method Method) (*QueueMember, error) {
+	return v.DequeueMember(queueSID, FrontOfQueue, redirectURL, method)
+}
+
+func (v *VTwilio) postQueue(urlStr string, opts []QueueOption) (*Queue, error) {
+	c := &queueConfiguration{}
+	for _, o := range opts {
+		o(c)
+	}
+	if c.MaxSize < 0 {
+		return nil, fmt.Errorf("queue max size can not be negative")
+	}
+
+	values := url.Values{}
+	if c.FriendlyName != "" {
+		values.Set("FriendlyName", c.FriendlyName)
+	}
+	if c.MaxSize > 0 {
+		values.Set("MaxSize", strconv.Itoa(c.MaxSize))
+	}
+	req, err := http.NewRequest("POST", urlStr, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleQueue(req)
+}
+
+func queueListQuery(opts []QueueListOption) string {
+	c := &queueListConfiguration{
+		PageSize: 10,
+		Page:     0,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	values := url.Values{}
+	values.Set("PageSize", strconv.Itoa(c.PageSize))
+	values.Set("Page", strconv.Itoa(c.Page))
+	return values.Encode()
+}
+
+func (v *VTwilio) queueURL(sid, sub string) string {
+	return fmt.Sprintf("%s%s%s/%s%s.json", v.baseAPI, v.accountSID, queuesAPI, sid, sub)
+}
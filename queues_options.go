@@ -0,0 +1,45 @@
+package vtwilio
+
+type queueConfiguration struct {
+	FriendlyName string
+	MaxSize      int
+}
+
+// QueueOption is an option for creating or updating a queue
+type QueueOption func(*queueConfiguration)
+
+// QueueFriendlyName is a name for the queue, it must be unique in the account
+func QueueFriendlyName(name string) QueueOption {
+	return func(q *queueConfiguration) {
+		q.FriendlyName = name
+	}
+}
+
+// QueueMaxSize is the most calls the queue can hold, Twilio defaults to 100 and allows up to 5000
+func QueueMaxSize(size int) QueueOption {
+	return func(q *queueConfiguration) {
+		q.MaxSize = size
+	}
+}
+
+type queueListConfiguration struct {
+	PageSize int
+	Page     int
+}
+
+// QueueListOption is an option for listing queues or the members of a queue
+type QueueListOption func(*queueListConfiguration)
+
+// QueuePageSize sets the page size, defaults to 10
+func QueuePageSize(pageSize int) QueueListOption {
+	return func(c *queueListConfiguration) {
+		c.PageSize = pageSize
+	}
+}
+
+// QueuePage sets the page number, defaults to 0
+func QueuePage(page int) QueueListOption {
+	return func(c *queueListConfiguration) {
+		c.Page = page
+	}
+}
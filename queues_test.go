@@ -0,0 +1,157 @@
+package vtwilio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueMemberJSON(t *testing.T) {
+	expected := &QueueMember{
+		CallSID:      "CA123",
+		QueueSID:     "QU123",
+		Position:     1,
+		DateEnqueued: time.Date(2018, time.March, 2, 15, 4, 5, 0, time.UTC),
+		WaitTime:     143 * time.Second,
+	}
+
+	var actual QueueMember
+	assert.NoError(t, json.Unmarshal([]byte(`{"call_sid": "CA123", "queue_sid": "QU123", "position": 1, "date_enqueued": "Fri, 2 Mar 2018 15:04:05 +0000", "wait_time": 143}`), &actual))
+	assert.Equal(t, expected, &actual)
+
+	b, err := json.Marshal(expected)
+	assert.NoError(t, err)
+	var roundTrip QueueMember
+	assert.NoError(t, json.Unmarshal(b, &roundTrip))
+	assert.Equal(t, expected, &roundTrip)
+}
+
+func TestQueueRequests(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(v *VTwilio) (interface{}, error)
+		expectedMethod string
+		expectedPath   string
+		expectedQuery  url.Values
+		expectedForm   url.Values
+		response       string
+		expected       interface{}
+		expectedError  error
+	}{
+		{
+			name:           "create",
+			call:           func(v *VTwilio) (interface{}, error) { return v.CreateQueue("support", QueueMaxSize(20)) },
+			expectedMethod: "POST",
+			expectedPath:   "/sid/Queues.json",
+			expectedForm:   url.Values{"FriendlyName": {"support"}, "MaxSize": {"20"}},
+			response:       `{"sid": "QU123", "friendly_name": "support", "max_size": 20}`,
+			expected:       &Queue{SID: "QU123", FriendlyName: "support", MaxSize: 20},
+		},
+		{
+			name:           "update",
+			call:           func(v *VTwilio) (interface{}, error) { return v.UpdateQueue("QU123", QueueMaxSize(50)) },
+			expectedMethod: "POST",
+			expectedPath:   "/sid/Queues/QU123.json",
+			expectedForm:   url.Values{"MaxSize": {"50"}},
+			response:       `{"sid": "QU123", "max_size": 50, "current_size": 3, "average_wait_time": 90}`,
+			expected:       &Queue{SID: "QU123", MaxSize: 50, CurrentSize: 3, AverageWaitTime: 90 * time.Second},
+		},
+		{
+			name:           "list",
+			call:           func(v *VTwilio) (interface{}, error) { return v.ListQueues(QueuePageSize(5)) },
+			expectedMethod: "GET",
+			expectedPath:   "/sid/Queues.json",
+			expectedQuery:  url.Values{"PageSize": {"5"}, "Page": {"0"}},
+			response:       `{"page_size": 5, "queues": [{"sid": "QU123"}]}`,
+			expected:       &QueueList{PageSize: 5, Queues: []*Queue{{SID: "QU123"}}},
+		},
+		{
+			name:           "list members",
+			call:           func(v *VTwilio) (interface{}, error) { return v.ListQueueMembers("QU123", QueuePage(1)) },
+			expectedMethod: "GET",
+			expectedPath:   "/sid/Queues/QU123/Members.json",
+			expectedQuery:  url.Values{"PageSize": {"10"}, "Page": {"1"}},
+			response:       `{"page": 1, "queue_members": [{"call_sid": "CA123", "position": 1, "wait_time": 30}]}`,
+			expected:       &QueueMemberList{Page: 1, Members: []*QueueMember{{CallSID: "CA123", Position: 1, WaitTime: 30 * time.Second}}},
+		},
+		{
+			name: "dequeue member",
+			call: func(v *VTwilio) (interface{}, error) {
+				return v.DequeueMember("QU123", "CA123", "http://url.com/agent", POST)
+			},
+			expectedMethod: "POST",
+			expectedPath:   "/sid/Queues/QU123/Members/CA123.json",
+			expectedForm:   url.Values{"Url": {"http://url.com/agent"}, "Method": {"POST"}},
+			response:       `{"call_sid": "CA123"}`,
+			expected:       &QueueMember{CallSID: "CA123"},
+		},
+		{
+			name:           "dequeue front",
+			call:           func(v *VTwilio) (interface{}, error) { return v.DequeueFront("QU123", "http://url.com/agent", "") },
+			expectedMethod: "POST",
+			expectedPath:   "/sid/Queues/QU123/Members/Front.json",
+			expectedForm:   url.Values{"Url": {"http://url.com/agent"}},
+			response:       `{"call_sid": "CA456"}`,
+			expected:       &QueueMember{CallSID: "CA456"},
+		},
+		{
+			name:          "create without name",
+			call:          func(v *VTwilio) (interface{}, error) { return v.CreateQueue("") },
+			expectedError: fmt.Errorf("queue must have a friendly name"),
+		},
+		{
+			name:          "dequeue without url",
+			call:          func(v *VTwilio) (interface{}, error) { return v.DequeueFront("QU123", "", POST) },
+			expectedError: fmt.Errorf("must contain a url to redirect the call to"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedMethod, r.Method)
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				if tt.expectedQuery != nil {
+					assert.Equal(t, tt.expectedQuery, r.URL.Query())
+				}
+				if tt.expectedForm != nil {
+					body, err := ioutil.ReadAll(r.Body)
+					if err != nil {
+						t.Errorf("failed to read body: %v", err)
+					}
+					form, err := url.ParseQuery(string(body))
+					assert.NoError(t, err)
+					assert.Equal(t, tt.expectedForm, form)
+				}
+				fmt.Fprint(w, tt.response)
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+			actual, err := tt.call(v)
+			assert.Equal(t, tt.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDeleteQueue(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/sid/Queues/QU123.json", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	assert.NoError(t, v.DeleteQueue("QU123"))
+}
@@ -0,0 +1,171 @@
+package vtwilio
+
+import (
+	"fmt"
+	"time"
+)
+
+// QuietHoursAction is what happens to a send that falls inside quiet hours
+type QuietHoursAction int
+
+const (
+	// RejectQuietHours refuses the send and returns a *QuietHoursError
+	RejectQuietHours QuietHoursAction = iota
+	// DeferQuietHours blocks until quiet hours end in the recipient's time zone and then sends
+	DeferQuietHours
+	// ScheduleQuietHours sends the message as a Twilio scheduled message that is delivered when quiet hours end.
+	// Scheduled messages require a messaging service, see MessagingServiceSID.
+	ScheduleQuietHours
+)
+
+// minimumScheduleLead is the shortest delay Twilio accepts for a scheduled message
+const minimumScheduleLead = 15 * time.Minute
+
+// QuietHours is a send policy that keeps messages from being delivered during a
+// window of the recipient's local day
+type QuietHours struct {
+	start    int
+	end      int
+	action   QuietHoursAction
+	fallback *time.Location
+	now      func() time.Time
+	sleep    func(time.Duration)
+}
+
+// QuietHoursOption is an option for quiet hours
+type QuietHoursOption func(*QuietHours)
+
+// QuietHoursFallback is the location used when the recipient's time zone cannot be inferred.
+// Without a fallback such sends fail.
+func QuietHoursFallback(loc *time.Location) QuietHoursOption {
+	return func(q *QuietHours) {
+		q.fallback = loc
+	}
+}
+
+// QuietHoursClock overrides the clock used to decide if a send is inside quiet hours
+func QuietHoursClock(now func() time.Time) QuietHoursOption {
+	return func(q *QuietHours) {
+		q.now = now
+	}
+}
+
+// QuietHoursSleep overrides how a deferred send waits for quiet hours to end
+func QuietHoursSleep(sleep func(time.Duration)) QuietHoursOption {
+	return func(q *QuietHours) {
+		q.sleep = sleep
+	}
+}
+
+// NewQuietHours returns a quiet hours policy. start and end are 24 hour "15:04"
+// times in the recipient's local time, a window may wrap past midnight e.g. "21:00" to "08:00".
+func NewQuietHours(start, end string, action QuietHoursAction, opts ...QuietHoursOption) (*QuietHours, error) {
+	s, err := parseClock(start)
+	if err != nil {
+		return nil, err
+	}
+	e, err := parseClock(end)
+	if err != nil {
+		return nil, err
+	}
+	if s == e {
+		return nil, fmt.Errorf("quiet hours start and end must be different")
+	}
+
+	q := &QuietHours{
+		start:  s,
+		end:    e,
+		action: action,
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+	for _, o := range opts {
+		o(q)
+	}
+	return q, nil
+}
+
+func parseClock(c string) (int, error) {
+	t, err := time.Parse("15:04", c)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quiet hours time %q, expected HH:MM", c)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// QuietHoursDecision is the result of checking a send against quiet hours
+type QuietHoursDecision struct {
+	Location *time.Location
+	Local    time.Time
+	Quiet    bool
+	Resume   time.Time
+}
+
+// QuietHoursError is returned when a send is refused because of quiet hours
+type QuietHoursError struct {
+	To     string
+	Resume time.Time
+}
+
+func (e *QuietHoursError) Error() string {
+	return fmt.Sprintf("quiet hours for %v until %v", e.To, e.Resume.Format(time.RFC3339))
+}
+
+// Check decides whether a message sent to the number now would be inside quiet hours
+func (q *QuietHours) Check(to string) (*QuietHoursDecision, error) {
+	loc, err := TimezoneForNumber(to)
+	if err != nil {
+		if q.fallback == nil {
+			return nil, err
+		}
+		loc = q.fallback
+	}
+
+	local := q.now().In(loc)
+	d := &QuietHoursDecision{Location: loc, Local: local}
+	minute := local.Hour()*60 + local.Minute()
+	if q.start < q.end {
+		d.Quiet = minute >= q.start && minute < q.end
+	} else {
+		d.Quiet = minute >= q.start || minute < q.end
+	}
+	if !d.Quiet {
+		return d, nil
+	}
+
+	resume := time.Date(local.Year(), local.Month(), local.Day(), q.end/60, q.end%60, 0, 0, loc)
+	if !resume.After(local) {
+		resume = resume.AddDate(0, 0, 1)
+	}
+	d.Resume = resume
+	return d, nil
+}
+
+// apply enforces the policy on a send, it may block, refuse or reschedule it
+func (q *QuietHours) apply(to string, c *sendConfiguration) error {
+	d, err := q.Check(to)
+	if err != nil {
+		return err
+	}
+	if !d.Quiet {
+		return nil
+	}
+
+	switch q.action {
+	case DeferQuietHours:
+		q.sleep(d.Resume.Sub(d.Local))
+		return nil
+	case ScheduleQuietHours:
+		if c.MessagingServiceSID == "" {
+			return fmt.Errorf("scheduling a message requires a messaging service sid")
+		}
+		sendAt := d.Resume
+		if earliest := q.now().Add(minimumScheduleLead); sendAt.Before(earliest) {
+			sendAt = earliest
+		}
+		c.SendAt = sendAt
+		return nil
+	default:
+		return &QuietHoursError{To: to, Resume: d.Resume}
+	}
+}
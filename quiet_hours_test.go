@@ -0,0 +1,231 @@
+package vtwilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func clockAt(t time.Time) func() time.Time {
+	return func() time.Time { return t }
+}
+
+func TestNewQuietHours(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+	}{
+		{"bad start", "9pm", "08:00"},
+		{"bad end", "21:00", "25:00"},
+		{"empty window", "21:00", "21:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewQuietHours(tt.start, tt.end, RejectQuietHours)
+			assert.Nil(t, q)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestQuietHoursCheck(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	la, _ := time.LoadLocation("America/Los_Angeles")
+
+	tests := []struct {
+		name           string
+		start, end     string
+		now            time.Time
+		to             string
+		fallback       *time.Location
+		expectedQuiet  bool
+		expectedResume time.Time
+		expectedError  bool
+	}{
+		{
+			name:          "afternoon",
+			start:         "21:00",
+			end:           "08:00",
+			now:           time.Date(2017, time.August, 31, 15, 0, 0, 0, ny),
+			to:            "+12125551234",
+			expectedQuiet: false,
+		},
+		{
+			name:           "late evening wraps to next morning",
+			start:          "21:00",
+			end:            "08:00",
+			now:            time.Date(2017, time.August, 31, 22, 30, 0, 0, ny),
+			to:             "+12125551234",
+			expectedQuiet:  true,
+			expectedResume: time.Date(2017, time.September, 1, 8, 0, 0, 0, ny),
+		},
+		{
+			name:           "early morning",
+			start:          "21:00",
+			end:            "08:00",
+			now:            time.Date(2017, time.August, 31, 6, 0, 0, 0, ny),
+			to:             "+12125551234",
+			expectedQuiet:  true,
+			expectedResume: time.Date(2017, time.August, 31, 8, 0, 0, 0, ny),
+		},
+		{
+			name:          "recipient time zone is used",
+			start:         "21:00",
+			end:           "08:00",
+			now:           time.Date(2017, time.August, 31, 22, 30, 0, 0, ny),
+			to:            "+14155551234",
+			expectedQuiet: false,
+		},
+		{
+			name:           "window inside a day",
+			start:          "12:00",
+			end:            "13:00",
+			now:            time.Date(2017, time.August, 31, 12, 15, 0, 0, ny),
+			to:             "+12125551234",
+			expectedQuiet:  true,
+			expectedResume: time.Date(2017, time.August, 31, 13, 0, 0, 0, ny),
+		},
+		{
+			name:          "unknown time zone",
+			start:         "21:00",
+			end:           "08:00",
+			now:           time.Date(2017, time.August, 31, 22, 30, 0, 0, ny),
+			to:            "+9991234567",
+			expectedError: true,
+		},
+		{
+			name:           "unknown time zone with fallback",
+			start:          "21:00",
+			end:            "08:00",
+			now:            time.Date(2017, time.August, 31, 22, 30, 0, 0, ny),
+			to:             "+9991234567",
+			fallback:       ny,
+			expectedQuiet:  true,
+			expectedResume: time.Date(2017, time.September, 1, 8, 0, 0, 0, ny),
+		},
+		{
+			name:          "unknown area code uses fallback",
+			start:         "21:00",
+			end:           "08:00",
+			now:           time.Date(2017, time.August, 31, 22, 30, 0, 0, ny),
+			to:            "+15555551234",
+			fallback:      la,
+			expectedQuiet: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []QuietHoursOption{QuietHoursClock(clockAt(tt.now))}
+			if tt.fallback != nil {
+				opts = append(opts, QuietHoursFallback(tt.fallback))
+			}
+			q, err := NewQuietHours(tt.start, tt.end, RejectQuietHours, opts...)
+			assert.NoError(t, err)
+
+			actual, err := q.Check(tt.to)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedQuiet, actual.Quiet)
+			assert.True(t, tt.expectedResume.Equal(actual.Resume), "expected resume %v, got %v", tt.expectedResume, actual.Resume)
+		})
+	}
+}
+
+func TestSendQuietHours(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	night := time.Date(2017, time.August, 31, 22, 30, 0, 0, ny)
+	resume := time.Date(2017, time.September, 1, 8, 0, 0, 0, ny)
+
+	tests := []struct {
+		name          string
+		action        QuietHoursAction
+		opts          []SendOption
+		expectedSent  bool
+		expectedSlept time.Duration
+		expectedForm  url.Values
+		expectedError error
+	}{
+		{
+			name:          "reject",
+			action:        RejectQuietHours,
+			expectedError: &QuietHoursError{To: "+12125551234", Resume: resume},
+		},
+		{
+			name:          "ignored for transactional messages",
+			action:        RejectQuietHours,
+			opts:          []SendOption{IgnoreQuietHours()},
+			expectedSent:  true,
+			expectedForm:  url.Values{"To": {"+12125551234"}, "From": {"+12345678910"}, "Body": {"message"}},
+			expectedError: nil,
+		},
+		{
+			name:          "defer",
+			action:        DeferQuietHours,
+			expectedSent:  true,
+			expectedSlept: 9*time.Hour + 30*time.Minute,
+			expectedForm:  url.Values{"To": {"+12125551234"}, "From": {"+12345678910"}, "Body": {"message"}},
+		},
+		{
+			name:         "schedule",
+			action:       ScheduleQuietHours,
+			opts:         []SendOption{MessagingServiceSID("MG123")},
+			expectedSent: true,
+			expectedForm: url.Values{
+				"To":                  {"+12125551234"},
+				"From":                {"+12345678910"},
+				"Body":                {"message"},
+				"MessagingServiceSid": {"MG123"},
+				"SendAt":              {"2017-09-01T12:00:00Z"},
+				"ScheduleType":        {"fixed"},
+			},
+		},
+		{
+			name:          "schedule without messaging service",
+			action:        ScheduleQuietHours,
+			expectedError: fmt.Errorf("scheduling a message requires a messaging service sid"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := false
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sent = true
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read body: %v", err)
+				}
+				form, err := url.ParseQuery(string(body))
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedForm, form)
+				w.Write([]byte(`{"sid": "sid"}`))
+			}))
+			defer ts.Close()
+
+			var slept time.Duration
+			q, err := NewQuietHours("21:00", "08:00", tt.action,
+				QuietHoursClock(clockAt(night)),
+				QuietHoursSleep(func(d time.Duration) { slept = d }))
+			assert.NoError(t, err)
+
+			v := NewVTwilio("sid", "token", TwilioNumber("+12345678910"), QuietHoursPolicy(q))
+			v.baseAPI = fmt.Sprintf("%s/", ts.URL)
+
+			_, err = v.SendMessage("message", "+12125551234", tt.opts...)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedSent, sent)
+			assert.Equal(t, tt.expectedSlept, slept)
+		})
+	}
+}
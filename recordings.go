@@ -0,0 +1,299 @@
+package vtwilio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Recording is a call recording from Twilio
+type Recording struct {
+	SID           string          `json:"sid"`
+	AccountSID    string          `json:"account_sid"`
+	CallSID       string          `json:"call_sid"`
+	ConferenceSID string          `json:"conference_sid"`
+	DateCreated   time.Time       `json:"-"`
+	DateUpdated   time.Time       `json:"-"`
+	StartTime     time.Time       `json:"-"`
+	Duration      time.Duration   `json:"-"`
+	Channels      int             `json:"channels"`
+	Source        string          `json:"source"`
+	Status        RecordingStatus `json:"status"`
+	ErrorCode     int             `json:"error_code"`
+	Price         float64         `json:"-"`
+	PriceUnit     string          `json:"price_unit"`
+	APIVersion    string          `json:"api_version"`
+	URI           string          `json:"uri"`
+}
+
+// recordingTimes are the recording fields Twilio sends as strings that are parsed into typed fields
+type recordingTimes struct {
+	DateCreated string `json:"date_created"`
+	DateUpdated string `json:"date_updated"`
+	StartTime   string `json:"start_time"`
+	Duration    string `json:"duration"`
+	Price       string `json:"price"`
+}
+
+// UnmarshalJSON parses the recording's times, duration and price
+func (r *Recording) UnmarshalJSON(b []byte) error {
+	type recording Recording
+	aux := struct {
+		*recording
+		recordingTimes
+	}{recording: (*recording)(r)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if aux.recordingTimes.DateCreated != "" {
+		if r.DateCreated, err = ToTime(aux.recordingTimes.DateCreated); err != nil {
+			return err
+		}
+	}
+	if aux.recordingTimes.DateUpdated != "" {
+		if r.DateUpdated, err = ToTime(aux.recordingTimes.DateUpdated); err != nil {
+			return err
+		}
+	}
+	if aux.recordingTimes.StartTime != "" {
+		if r.StartTime, err = ToTime(aux.recordingTimes.StartTime); err != nil {
+			return err
+		}
+	}
+	if aux.recordingTimes.Duration != "" {
+		seconds, err := strconv.Atoi(aux.recordingTimes.Duration)
+		if err != nil {
+			return fmt.Errorf("invalid recording duration %q", aux.recordingTimes.Duration)
+		}
+		r.Duration = time.Duration(seconds) * time.Second
+	}
+	if aux.recordingTimes.Price != "" {
+		if r.Price, err = strconv.ParseFloat(aux.recordingTimes.Price, 64); err != nil {
+			return fmt.Errorf("invalid recording price %q", aux.recordingTimes.Price)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the recording in the format Twilio uses
+func (r Recording) MarshalJSON() ([]byte, error) {
+	type recording Recording
+	aux := struct {
+		recording
+		recordingTimes
+	}{recording: recording(r)}
+	if !r.DateCreated.IsZero() {
+		aux.recordingTimes.DateCreated = r.DateCreated.Format(twilioTimeFormat)
+	}
+	if !r.DateUpdated.IsZero() {
+		aux.recordingTimes.DateUpdated = r.DateUpdated.Format(twilioTimeFormat)
+	}
+	if !r.StartTime.IsZero() {
+		aux.recordingTimes.StartTime = r.StartTime.Format(twilioTimeFormat)
+	}
+	if r.Duration != 0 {
+		aux.recordingTimes.Duration = strconv.Itoa(int(r.Duration / time.Second))
+	}
+	if r.Price != 0 {
+		aux.recordingTimes.Price = strconv.FormatFloat(r.Price, 'f', -1, 64)
+	}
+	return json.Marshal(aux)
+}
+
+// RecordingList is a page of recordings
+type RecordingList struct {
+	FirstPageURI    string       `json:"first_page_uri"`
+	NextPageURI     string       `json:"next_page_uri"`
+	PreviousPageURI string       `json:"previous_page_uri"`
+	Page            int          `json:"page"`
+	PageSize        int          `json:"page_size"`
+	End             int          `json:"end"`
+	Recordings      []*Recording `json:"recordings"`
+}
+
+// RecordingArchive is the result of archiving recordings
+type RecordingArchive struct {
+	// Archived maps the sid of each recording that was saved and deleted to its file
+	Archived map[string]string
+	// Failed maps the sid of each recording that could not be archived to the error.
+	// A failed recording is never deleted from Twilio.
+	Failed map[string]error
+}
+
+// ListRecordings returns a page of recordings
+func (v *VTwilio) ListRecordings(opts ...RecordingListOption) (*RecordingList, error) {
+	c := &recordingListConfiguration{
+		PageSize: 10,
+		Page:     0,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	values := url.Values{}
+	values.Set("PageSize", strconv.Itoa(c.PageSize))
+	values.Set("Page", strconv.Itoa(c.Page))
+	if c.CallSID != "" {
+		values.Set("CallSid", c.CallSID)
+	}
+	if !c.Date.IsZero() {
+		date := c.Date.UTC().Format("2006-01-02")
+		switch c.DateRange {
+		case before:
+			values.Set("DateCreated<", date)
+		case after:
+			values.Set("DateCreated>", date)
+		default:
+			values.Set("DateCreated", date)
+		}
+	}
+
+	urlStr := fmt.Sprintf("%s%s%s.json?%s", v.baseAPI, v.accountSID, recordingsAPI, values.Encode())
+	return v.listRecordings(urlStr)
+}
+
+func (v *VTwilio) listRecordings(urlStr string) (*RecordingList, error) {
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleListRecordings(req)
+}
+
+// GetRecording gets a recording's metadata by its sid
+func (v *VTwilio) GetRecording(sid string) (*Recording, error) {
+	if sid == "" {
+		return nil, fmt.Errorf("must contain a recording SID")
+	}
+	req, err := http.NewRequest("GET", v.recordingURL(sid, "json"), nil)
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleRecording(req)
+}
+
+// DownloadRecording streams a recording's audio to w
+func (v *VTwilio) DownloadRecording(sid string, format RecordingFormat, w io.Writer) error {
+	if sid == "" {
+		return fmt.Errorf("must contain a recording SID")
+	}
+	if format != WAV && format != MP3 {
+		return fmt.Errorf("invalid recording format %v", format)
+	}
+	req, err := http.NewRequest("GET", v.recordingURL(sid, string(format)), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(v.accountSID, v.authToken)
+	return handleStream(req, w)
+}
+
+// DeleteRecording permanently deletes a recording
+func (v *VTwilio) DeleteRecording(sid string) error {
+	if sid == "" {
+		return fmt.Errorf("must contain a recording SID")
+	}
+	req, err := http.NewRequest("DELETE", v.recordingURL(sid, "json"), nil)
+	if err != nil {
+		return err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return genericHandler(req)
+}
+
+// ArchiveRecordings downloads every recording created before cutoff to dir
+// as <sid>.<format> and then deletes it from Twilio. A recording is only deleted once its
+// file has been written, recordings that fail are reported and left on Twilio.
+func (v *VTwilio) ArchiveRecordings(dir string, cutoff time.Time, format RecordingFormat) (*RecordingArchive, error) {
+	if cutoff.IsZero() {
+		return nil, fmt.Errorf("must contain a cutoff time")
+	}
+	if format != WAV && format != MP3 {
+		return nil, fmt.Errorf("invalid recording format %v", format)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	old := []*Recording{}
+	list, err := v.ListRecordings(RecordingsCreatedOnOrBefore(cutoff), RecordingsPageSize(100))
+	for {
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range list.Recordings {
+			if r.DateCreated.Before(cutoff) {
+				old = append(old, r)
+			}
+		}
+		if list.NextPageURI == "" {
+			break
+		}
+		next, perr := v.pageURL(list.NextPageURI)
+		if perr != nil {
+			return nil, perr
+		}
+		list, err = v.listRecordings(next)
+	}
+
+	archive := &RecordingArchive{Archived: map[string]string{}, Failed: map[string]error{}}
+	for _, r := range old {
+		path := filepath.Join(dir, fmt.Sprintf("%s.%s", r.SID, format))
+		if err := v.saveRecording(r.SID, format, path); err != nil {
+			archive.Failed[r.SID] = err
+			continue
+		}
+		if err := v.DeleteRecording(r.SID); err != nil {
+			archive.Failed[r.SID] = err
+			continue
+		}
+		archive.Archived[r.SID] = path
+	}
+	return archive, nil
+}
+
+// saveRecording downloads a recording to a temporary file and renames it to path once complete
+func (v *VTwilio) saveRecording(sid string, format RecordingFormat, path string) error {
+	tmp := path + ".part"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := v.DownloadRecording(sid, format, f); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (v *VTwilio) recordingURL(sid, ext string) string {
+	return fmt.Sprintf("%s%s%s/%s.%s", v.baseAPI, v.accountSID, recordingsAPI, sid, ext)
+}
+
+// pageURL resolves a next or previous page uri from a list response against the api host
+func (v *VTwilio) pageURL(uri string) (string, error) {
+	base, err := url.Parse(v.baseAPI)
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(ref).String(), nil
+}
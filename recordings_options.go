@@ -0,0 +1,71 @@
+package vtwilio
+
+import (
+	"time"
+)
+
+// RecordingFormat is the audio format of a downloaded recording
+type RecordingFormat string
+
+const (
+	// WAV uncompressed audio
+	WAV RecordingFormat = "wav"
+	// MP3 compressed audio
+	MP3 RecordingFormat = "mp3"
+)
+
+type recordingListConfiguration struct {
+	CallSID   string
+	Date      time.Time
+	DateRange dateOption
+	PageSize  int
+	Page      int
+}
+
+// RecordingListOption is an option for listing recordings
+type RecordingListOption func(*recordingListConfiguration)
+
+// RecordingsForCall only lists the recordings of a call
+func RecordingsForCall(callSID string) RecordingListOption {
+	return func(c *recordingListConfiguration) {
+		c.CallSID = callSID
+	}
+}
+
+// RecordingsCreatedOn only lists recordings created on the day
+func RecordingsCreatedOn(date time.Time) RecordingListOption {
+	return func(c *recordingListConfiguration) {
+		c.Date = date
+		c.DateRange = equal
+	}
+}
+
+// RecordingsCreatedOnOrBefore only lists recordings created on or before the day
+func RecordingsCreatedOnOrBefore(date time.Time) RecordingListOption {
+	return func(c *recordingListConfiguration) {
+		c.Date = date
+		c.DateRange = before
+	}
+}
+
+// RecordingsCreatedOnOrAfter only lists recordings created on or after the day
+func RecordingsCreatedOnOrAfter(date time.Time) RecordingListOption {
+	return func(c *recordingListConfiguration) {
+		c.Date = date
+		c.DateRange = after
+	}
+}
+
+// RecordingsPageSize sets the page size, defaults to 10
+func RecordingsPageSize(pageSize int) RecordingListOption {
+	return func(c *recordingListConfiguration) {
+		c.PageSize = pageSize
+	}
+}
+
+// RecordingsPage sets the page number, defaults to 0
+func RecordingsPage(page int) RecordingListOption {
+	return func(c *recordingListConfiguration) {
+		c.Page = page
+	}
+}
@@ -0,0 +1,202 @@
+package vtwilio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const recordingResponse = `{
+	"sid": "RE123",
+	"call_sid": "CA123",
+	"date_created": "Thu, 31 Aug 2017 01:10:56 +0000",
+	"date_updated": "Thu, 31 Aug 2017 01:11:12 +0000",
+	"start_time": "Thu, 31 Aug 2017 01:09:41 +0000",
+	"duration": "75",
+	"channels": 1,
+	"source": "DialVerb",
+	"status": "completed",
+	"price": "-0.0025",
+	"price_unit": "USD"
+}`
+
+func TestRecordingJSON(t *testing.T) {
+	expected := &Recording{
+		SID:         "RE123",
+		CallSID:     "CA123",
+		DateCreated: time.Date(2017, time.August, 31, 1, 10, 56, 0, time.UTC),
+		DateUpdated: time.Date(2017, time.August, 31, 1, 11, 12, 0, time.UTC),
+		StartTime:   time.Date(2017, time.August, 31, 1, 9, 41, 0, time.UTC),
+		Duration:    75 * time.Second,
+		Channels:    1,
+		Source:      "DialVerb",
+		Status:      RecordingCompleted,
+		Price:       -0.0025,
+		PriceUnit:   "USD",
+	}
+
+	var actual Recording
+	assert.NoError(t, json.Unmarshal([]byte(recordingResponse), &actual))
+	assert.Equal(t, expected, &actual)
+
+	b, err := json.Marshal(expected)
+	assert.NoError(t, err)
+	var roundTrip Recording
+	assert.NoError(t, json.Unmarshal(b, &roundTrip))
+	assert.Equal(t, expected, &roundTrip)
+}
+
+func TestListRecordings(t *testing.T) {
+	date := time.Date(2017, time.August, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		in            []RecordingListOption
+		expectedQuery url.Values
+	}{
+		{
+			name:          "no options",
+			expectedQuery: url.Values{"PageSize": {"10"}, "Page": {"0"}},
+		},
+		{
+			name:          "for call",
+			in:            []RecordingListOption{RecordingsForCall("CA123"), RecordingsPageSize(5), RecordingsPage(1)},
+			expectedQuery: url.Values{"PageSize": {"5"}, "Page": {"1"}, "CallSid": {"CA123"}},
+		},
+		{
+			name:          "on date",
+			in:            []RecordingListOption{RecordingsCreatedOn(date)},
+			expectedQuery: url.Values{"PageSize": {"10"}, "Page": {"0"}, "DateCreated": {"2017-08-31"}},
+		},
+		{
+			name:          "on or before date",
+			in:            []RecordingListOption{RecordingsCreatedOnOrBefore(date)},
+			expectedQuery: url.Values{"PageSize": {"10"}, "Page": {"0"}, "DateCreated<": {"2017-08-31"}},
+		},
+		{
+			name:          "on or after date",
+			in:            []RecordingListOption{RecordingsCreatedOnOrAfter(date)},
+			expectedQuery: url.Values{"PageSize": {"10"}, "Page": {"0"}, "DateCreated>": {"2017-08-31"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/sid/Recordings.json", r.URL.Path)
+				assert.Equal(t, tt.expectedQuery, r.URL.Query())
+				w.Write([]byte(`{"recordings": [` + recordingResponse + `]}`))
+			}))
+			defer ts.Close()
+
+			v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+			actual, err := v.ListRecordings(tt.in...)
+			assert.NoError(t, err)
+			assert.Len(t, actual.Recordings, 1)
+			assert.Equal(t, "RE123", actual.Recordings[0].SID)
+		})
+	}
+}
+
+func TestRecordingRequests(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/sid/Recordings/RE123.json":
+			w.Write([]byte(recordingResponse))
+		case r.Method == "GET" && r.URL.Path == "/sid/Recordings/RE123.mp3":
+			user, pass, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "sid", user)
+			assert.Equal(t, "token", pass)
+			w.Write([]byte("mp3 audio"))
+		case r.Method == "DELETE" && r.URL.Path == "/sid/Recordings/RE123.json":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "not found"}`))
+		}
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+
+	r, err := v.GetRecording("RE123")
+	assert.NoError(t, err)
+	assert.Equal(t, 75*time.Second, r.Duration)
+
+	var buf bytes.Buffer
+	assert.NoError(t, v.DownloadRecording("RE123", MP3, &buf))
+	assert.Equal(t, "mp3 audio", buf.String())
+
+	assert.Equal(t, fmt.Errorf("Error: not found"), v.DownloadRecording("RE404", WAV, &buf))
+	assert.Equal(t, fmt.Errorf("invalid recording format ogg"), v.DownloadRecording("RE123", "ogg", &buf))
+	assert.NoError(t, v.DeleteRecording("RE123"))
+
+	_, err = v.GetRecording("")
+	assert.Equal(t, fmt.Errorf("must contain a recording SID"), err)
+	assert.Equal(t, fmt.Errorf("must contain a recording SID"), v.DeleteRecording(""))
+}
+
+func TestArchiveRecordings(t *testing.T) {
+	cutoff := time.Date(2017, time.September, 1, 0, 0, 0, 0, time.UTC)
+	deleted := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/sid/Recordings.json" && r.URL.Query().Get("Page") == "0":
+			assert.Equal(t, "100", r.URL.Query().Get("PageSize"))
+			assert.Equal(t, "2017-09-01", r.URL.Query().Get("DateCreated<"))
+			w.Write([]byte(`{"next_page_uri": "/sid/Recordings.json?Page=1&PageToken=PA1", "recordings": [
+				{"sid": "RE1", "date_created": "Thu, 31 Aug 2017 01:10:56 +0000"},
+				{"sid": "RE2", "date_created": "Fri, 01 Sep 2017 09:30:00 +0000"}
+			]}`))
+		case r.URL.Path == "/sid/Recordings.json" && r.URL.Query().Get("PageToken") == "PA1":
+			w.Write([]byte(`{"recordings": [
+				{"sid": "RE3", "date_created": "Thu, 31 Aug 2017 01:10:56 +0000"}
+			]}`))
+		case r.Method == "GET" && r.URL.Path == "/sid/Recordings/RE1.wav":
+			w.Write([]byte("RE1 audio"))
+		case r.Method == "GET" && r.URL.Path == "/sid/Recordings/RE3.wav":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message": "unavailable"}`))
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %v %v", r.Method, r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "recordings")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	actual, err := v.ArchiveRecordings(dir, cutoff, WAV)
+	assert.NoError(t, err)
+
+	path := filepath.Join(dir, "RE1.wav")
+	assert.Equal(t, map[string]string{"RE1": path}, actual.Archived)
+	assert.Equal(t, map[string]error{"RE3": fmt.Errorf("Error: unavailable")}, actual.Failed)
+	assert.Equal(t, []string{"/sid/Recordings/RE1.json"}, deleted)
+
+	audio, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "RE1 audio", string(audio))
+	_, err = os.Stat(filepath.Join(dir, "RE3.wav"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "RE3.wav.part"))
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = v.ArchiveRecordings(dir, time.Time{}, WAV)
+	assert.Equal(t, fmt.Errorf("must contain a cutoff time"), err)
+}
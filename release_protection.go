@@ -0,0 +1,181 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// quarantinePrefix starts the friendly name of a quarantined number, it is followed by the release time
+	quarantinePrefix     = "quarantined until "
+	quarantineTimeFormat = "2006-01-02T15:04Z"
+	maxFriendlyName      = 64
+)
+
+type releaseConfiguration struct {
+	OverrideProtection bool
+}
+
+// ReleaseOption is an option for releasing or quarantining a number
+type ReleaseOption func(*releaseConfiguration)
+
+// OverrideProtection releases or quarantines a number even if it is protected
+func OverrideProtection() ReleaseOption {
+	return func(c *releaseConfiguration) {
+		c.OverrideProtection = true
+	}
+}
+
+// ProtectedNumberError is returned when releasing or quarantining a protected number without OverrideProtection
+type ProtectedNumberError struct {
+	SID         string
+	PhoneNumber string
+	Reason      string
+}
+
+func (e *ProtectedNumberError) Error() string {
+	return fmt.Sprintf("%v (%v) is protected: %v", e.PhoneNumber, e.SID, e.Reason)
+}
+
+// QuarantineRelease is the result of releasing quarantined numbers
+type QuarantineRelease struct {
+	// Released maps the sid of each released number to the number
+	Released map[string]string
+	// Protected maps the sid of each due number that was kept because it is protected to the number
+	Protected map[string]string
+	// Failed maps the sid of each number that could not be released to the error
+	Failed map[string]error
+}
+
+// QuarantineNumber takes a number out of service without giving it up. Its webhooks are removed and it is renamed
+// to record when it may be released, ReleaseQuarantined releases it once grace has passed.
+func (v *VTwilio) QuarantineNumber(sid string, grace time.Duration, opts ...ReleaseOption) (*IncomingPhoneNumber, error) {
+	c := &releaseConfiguration{}
+	for _, o := range opts {
+		o(c)
+	}
+
+	n, err := v.GetIncomingPhoneNumber(sid)
+	if err != nil {
+		return nil, err
+	}
+	if !c.OverrideProtection {
+		if err := v.checkProtection(n); err != nil {
+			return nil, err
+		}
+	}
+
+	values := url.Values{}
+	values.Set("FriendlyName", quarantineName(n.FriendlyName, v.clock().Add(grace), v.protectedTag))
+	for _, webhook := range []string{"VoiceUrl", "VoiceFallbackUrl", "SmsUrl", "SmsFallbackUrl", "StatusCallback", "VoiceApplicationSid", "SmsApplicationSid"} {
+		values.Set(webhook, "")
+	}
+	req, err := http.NewRequest("POST", buildIncomingPhoneNumber(v.baseAPI, v.accountSID, sid), strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	setUpRequest(req, v.accountSID, v.authToken)
+	return handleIncomingPhoneNumbers(req)
+}
+
+// ReleaseQuarantined releases every quarantined number whose grace period has passed, numbers that
+// are protected now are kept
+func (v *VTwilio) ReleaseQuarantined() (*QuarantineRelease, error) {
+	numbers, err := v.allIncomingPhoneNumbers()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &QuarantineRelease{Released: map[string]string{}, Protected: map[string]string{}, Failed: map[string]error{}}
+	now := v.clock()
+	for _, n := range numbers {
+		releaseAt, ok := quarantinedUntil(n.FriendlyName)
+		if !ok || now.Before(releaseAt) {
+			continue
+		}
+		if err := v.checkProtection(n); err != nil {
+			result.Protected[n.SID] = n.PhoneNumber
+			continue
+		}
+		if err := v.ReleaseNumber(n.SID); err != nil {
+			result.Failed[n.SID] = err
+			continue
+		}
+		result.Released[n.SID] = n.PhoneNumber
+	}
+	return result, nil
+}
+
+func (v *VTwilio) clock() time.Time {
+	if v.now == nil {
+		return time.Now()
+	}
+	return v.now()
+}
+
+// protectionEnabled reports whether any numbers are protected
+func (v *VTwilio) protectionEnabled() bool {
+	return len(v.protectedNumbers) > 0 || v.protectedTag != ""
+}
+
+func (v *VTwilio) checkProtection(n *IncomingPhoneNumber) error {
+	for _, p := range v.protectedNumbers {
+		if p == n.SID {
+			return &ProtectedNumberError{SID: n.SID, PhoneNumber: n.PhoneNumber, Reason: "sid is in the protected list"}
+		}
+		if normalized, err := v.normalizeNumber(p); err == nil && normalized == n.PhoneNumber {
+			return &ProtectedNumberError{SID: n.SID, PhoneNumber: n.PhoneNumber, Reason: "number is in the protected list"}
+		}
+	}
+	if v.protectedTag != "" && strings.Contains(n.FriendlyName, v.protectedTag) {
+		return &ProtectedNumberError{SID: n.SID, PhoneNumber: n.PhoneNumber, Reason: fmt.Sprintf("friendly name is tagged %v", v.protectedTag)}
+	}
+	return nil
+}
+
+// quarantineName prefixes a friendly name with the quarantine release time, cutting the original
+// name short to fit Twilio's limit while keeping the protection tag
+func quarantineName(name string, releaseAt time.Time, protectedTag string) string {
+	if _, ok := quarantinedUntil(name); ok {
+		name = strings.TrimSpace(name[len(quarantinePrefix)+len(quarantineTimeFormat):])
+	}
+	prefix := fmt.Sprintf("%s%s ", quarantinePrefix, releaseAt.UTC().Format(quarantineTimeFormat))
+	if room := maxFriendlyName - len(prefix); len(name) > room {
+		shortened := truncate(name, room)
+		if protectedTag != "" && strings.Contains(name, protectedTag) && !strings.Contains(shortened, protectedTag) {
+			if len(protectedTag) >= room {
+				shortened = truncate(protectedTag, room)
+			} else {
+				shortened = strings.TrimSpace(truncate(name, room-len(protectedTag)-1)) + " " + protectedTag
+			}
+		}
+		name = shortened
+	}
+	return strings.TrimSpace(prefix + name)
+}
+
+// truncate cuts s to at most n bytes without splitting a character
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func quarantinedUntil(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, quarantinePrefix) || len(name) < len(quarantinePrefix)+len(quarantineTimeFormat) {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(quarantineTimeFormat, name[len(quarantinePrefix):len(quarantinePrefix)+len(quarantineTimeFormat)])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
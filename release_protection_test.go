@@ -0,0 +1,177 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseProtection(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []Option
+		releaseOpts   []ReleaseOption
+		expectedError error
+	}{
+		{
+			name:          "protected number",
+			opts:          []Option{ProtectNumbers("+13065550001")},
+			expectedError: &ProtectedNumberError{SID: "PN1", PhoneNumber: "+13065550001", Reason: "number is in the protected list"},
+		},
+		{
+			name:          "protected national number",
+			opts:          []Option{DefaultRegion("CA"), ProtectNumbers("(306) 555-0001")},
+			expectedError: &ProtectedNumberError{SID: "PN1", PhoneNumber: "+13065550001", Reason: "number is in the protected list"},
+		},
+		{
+			name:          "protected sid",
+			opts:          []Option{ProtectNumbers("PN1")},
+			expectedError: &ProtectedNumberError{SID: "PN1", PhoneNumber: "+13065550001", Reason: "sid is in the protected list"},
+		},
+		{
+			name:          "protected tag",
+			opts:          []Option{ProtectTag("[keep]")},
+			expectedError: &ProtectedNumberError{SID: "PN1", PhoneNumber: "+13065550001", Reason: "friendly name is tagged [keep]"},
+		},
+		{
+			name:        "override",
+			opts:        []Option{ProtectTag("[keep]")},
+			releaseOpts: []ReleaseOption{OverrideProtection()},
+		},
+		{
+			name: "not protected",
+			opts: []Option{ProtectNumbers("+13065550002"), ProtectTag("[protected]")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			released := false
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/sid/IncomingPhoneNumbers/PN1.json", r.URL.Path)
+				if r.Method == "DELETE" {
+					released = true
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				fmt.Fprint(w, `{"sid": "PN1", "phone_number": "+13065550001", "friendly_name": "Support [keep]"}`)
+			}))
+			defer ts.Close()
+
+			v := NewVTwilio("sid", "token", tt.opts...)
+			v.baseAPI = fmt.Sprintf("%s/", ts.URL)
+			err := v.ReleaseNumber("PN1", tt.releaseOpts...)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedError == nil, released)
+		})
+	}
+}
+
+func TestQuarantineNumber(t *testing.T) {
+	var update url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			r.ParseForm()
+			update = r.PostForm
+			fmt.Fprintf(w, `{"sid": "PN1", "friendly_name": %q}`, r.PostForm.Get("FriendlyName"))
+			return
+		}
+		fmt.Fprint(w, `{"sid": "PN1", "phone_number": "+13065550001", "friendly_name": "Support", "sms_url": "http://url.com/sms"}`)
+	}))
+	defer ts.Close()
+
+	now := time.Date(2018, time.March, 2, 12, 0, 0, 0, time.UTC)
+	v := NewVTwilio("sid", "token", ProtectTag("Support"), QuarantineClock(func() time.Time { return now }))
+	v.baseAPI = fmt.Sprintf("%s/", ts.URL)
+
+	_, err := v.QuarantineNumber("PN1", 24*time.Hour)
+	assert.IsType(t, &ProtectedNumberError{}, err)
+	assert.Nil(t, update)
+
+	n, err := v.QuarantineNumber("PN1", 24*time.Hour, OverrideProtection())
+	assert.NoError(t, err)
+	assert.Equal(t, "quarantined until 2018-03-03T12:00Z Support", n.FriendlyName)
+	for _, webhook := range []string{"SmsUrl", "VoiceUrl", "StatusCallback"} {
+		assert.Equal(t, []string{""}, update[webhook], webhook)
+	}
+}
+
+func TestReleaseQuarantined(t *testing.T) {
+	now := time.Date(2018, time.March, 2, 12, 0, 0, 0, time.UTC)
+	due := quarantineName("Old support line", now.Add(-time.Hour), "")
+	protected := quarantineName("Old [protected] line", now.Add(-time.Hour), "")
+	later := quarantineName("Sales", now.Add(time.Hour), "")
+	var released []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			released = append(released, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprintf(w, `{"incoming_phone_numbers": [
+			{"sid": "PN1", "phone_number": "+13065550001", "friendly_name": %q},
+			{"sid": "PN2", "phone_number": "+13065550002", "friendly_name": %q},
+			{"sid": "PN3", "phone_number": "+13065550003", "friendly_name": "Support"},
+			{"sid": "PN4", "phone_number": "+13065550004", "friendly_name": %q},
+			{"sid": "PN5", "phone_number": "+13065550005", "friendly_name": %q}
+		]}`, due, later, protected, due)
+	}))
+	defer ts.Close()
+
+	v := NewVTwilio("sid", "token", ProtectTag("[protected]"), ProtectNumbers("PN5"), QuarantineClock(func() time.Time { return now }))
+	v.baseAPI = fmt.Sprintf("%s/", ts.URL)
+	result, err := v.ReleaseQuarantined()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"PN1": "+13065550001"}, result.Released)
+	assert.Equal(t, map[string]string{"PN4": "+13065550004", "PN5": "+13065550005"}, result.Protected)
+	assert.Empty(t, result.Failed)
+	assert.Equal(t, []string{"/sid/IncomingPhoneNumbers/PN1.json"}, released)
+
+	now = now.Add(2 * time.Hour)
+	released = nil
+	result, err = v.ReleaseQuarantined()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"PN1": "+13065550001", "PN2": "+13065550002"}, result.Released)
+}
+
+func TestQuarantineName(t *testing.T) {
+	releaseAt := time.Date(2018, time.March, 2, 15, 4, 0, 0, time.UTC)
+	name := quarantineName("Support", releaseAt, "")
+	assert.Equal(t, "quarantined until 2018-03-02T15:04Z Support", name)
+
+	actual, ok := quarantinedUntil(name)
+	assert.True(t, ok)
+	assert.Equal(t, releaseAt, actual)
+
+	assert.Equal(t, "quarantined until 2018-03-03T15:04Z Support", quarantineName(name, releaseAt.AddDate(0, 0, 1), ""))
+
+	long := quarantineName(strings.Repeat("a", 64), releaseAt, "")
+	assert.Len(t, long, 64)
+	actual, ok = quarantinedUntil(long)
+	assert.True(t, ok)
+	assert.Equal(t, releaseAt, actual)
+
+	tagged := quarantineName(strings.Repeat("a", 40)+" [protected]", releaseAt, "[protected]")
+	assert.Len(t, tagged, 64)
+	assert.True(t, strings.HasSuffix(tagged, " [protected]"))
+
+	// 28 bytes are left for the name, the 14th é would straddle the limit
+	accented := quarantineName("a"+strings.Repeat("é", 20), releaseAt, "")
+	assert.True(t, utf8.ValidString(accented))
+	assert.Equal(t, "quarantined until 2018-03-02T15:04Z a"+strings.Repeat("é", 13), accented)
+
+	taggedAccented := quarantineName(strings.Repeat("é", 20)+" [protégé]", releaseAt, "[protégé]")
+	assert.True(t, utf8.ValidString(taggedAccented))
+	assert.True(t, strings.HasSuffix(taggedAccented, " [protégé]"))
+	assert.True(t, len(taggedAccented) <= 64)
+
+	_, ok = quarantinedUntil("Support")
+	assert.False(t, ok)
+}
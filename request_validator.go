@@ -0,0 +1,142 @@
+package vtwilio
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RequestValidator checks the X-Twilio-Signature of inbound webhook requests
+type RequestValidator struct {
+	tokens         []string
+	baseURL        *url.URL
+	trustForwarded bool
+}
+
+// RequestValidatorOption is an option for a request validator
+type RequestValidatorOption func(*RequestValidator)
+
+// ValidatorTokens adds auth tokens that are also accepted, use it to keep the old token valid while rotating it
+func ValidatorTokens(tokens ...string) RequestValidatorOption {
+	return func(rv *RequestValidator) {
+		rv.tokens = append(rv.tokens, tokens...)
+	}
+}
+
+// ValidatorBaseURL sets the scheme, host and port Twilio requests, e.g. "https://example.com", for servers behind a
+// proxy that rewrites them. The path and query of the request are kept.
+func ValidatorBaseURL(baseURL string) RequestValidatorOption {
+	return func(rv *RequestValidator) {
+		if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+			rv.baseURL = u
+		}
+	}
+}
+
+// ValidatorTrustForwardedHeaders uses the X-Forwarded-Proto, X-Forwarded-Host and X-Forwarded-Port headers set by a
+// proxy to rebuild the url Twilio requested. Only use it when the proxy overwrites these headers.
+func ValidatorTrustForwardedHeaders() RequestValidatorOption {
+	return func(rv *RequestValidator) {
+		rv.trustForwarded = true
+	}
+}
+
+// NewRequestValidator returns a validator for requests signed with authToken
+func NewRequestValidator(authToken string, opts ...RequestValidatorOption) *RequestValidator {
+	rv := &RequestValidator{tokens: []string{authToken}}
+	for _, o := range opts {
+		o(rv)
+	}
+	return rv
+}
+
+// Validate checks the signature of a webhook request. The body is read and replaced so handlers can still read it.
+func (rv *RequestValidator) Validate(r *http.Request) error {
+	signature := r.Header.Get(SignatureHeader)
+	if signature == "" {
+		return fmt.Errorf("missing %v header", SignatureHeader)
+	}
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	urlStr := rv.requestURL(r)
+	if r.URL.Query().Get("bodySHA256") != "" {
+		for _, token := range rv.tokens {
+			if ValidateRequestBody(token, urlStr, body, signature) {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid signature")
+	}
+
+	params := url.Values{}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if r.Method == "POST" && mediaType == "application/x-www-form-urlencoded" {
+		var err error
+		params, err = url.ParseQuery(string(body))
+		if err != nil {
+			return err
+		}
+	}
+	for _, token := range rv.tokens {
+		if ValidateRequest(token, urlStr, params, signature) {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid signature")
+}
+
+// Middleware rejects requests without a valid signature with 403 Forbidden
+func (rv *RequestValidator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := rv.Validate(r); err != nil {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestURL rebuilds the url Twilio requested
+func (rv *RequestValidator) requestURL(r *http.Request) string {
+	u := &url.URL{Scheme: "http", Host: r.Host}
+	if r.TLS != nil {
+		u.Scheme = "https"
+	}
+
+	if rv.trustForwarded {
+		if proto := forwardedValue(r, "X-Forwarded-Proto"); proto != "" {
+			u.Scheme = proto
+		}
+		if host := forwardedValue(r, "X-Forwarded-Host"); host != "" {
+			u.Host = host
+		}
+		if port := forwardedValue(r, "X-Forwarded-Port"); port != "" {
+			u.Host = net.JoinHostPort(u.Hostname(), port)
+		}
+	}
+	if rv.baseURL != nil {
+		u.Scheme = rv.baseURL.Scheme
+		u.Host = rv.baseURL.Host
+	}
+	return u.String() + r.URL.RequestURI()
+}
+
+// forwardedValue returns the value set by the proxy closest to Twilio
+func forwardedValue(r *http.Request, header string) string {
+	return strings.TrimSpace(strings.Split(r.Header.Get(header), ",")[0])
+}
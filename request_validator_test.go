@@ -0,0 +1,167 @@
+package vtwilio
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestValidatorMiddleware(t *testing.T) {
+	form := url.Values{"From": {"+13065551234"}, "Body": {"hi"}}
+	jsonBody := `{"property": "value", "boolean": true}`
+	jsonQuery := "?bodySHA256=0a1ff7634d9ab3b95db5c9a2dfe9416e41502b283a80c7cf19632632f96e6620"
+
+	tests := []struct {
+		name        string
+		validator   *RequestValidator
+		target      string
+		headers     map[string]string
+		contentType string
+		body        string
+		signedURL   string
+		signedWith  string
+		params      url.Values
+		expected    int
+	}{
+		{
+			name:       "valid form",
+			validator:  NewRequestValidator("token"),
+			target:     "http://example.com/sms?x=1",
+			body:       form.Encode(),
+			signedURL:  "http://example.com/sms?x=1",
+			signedWith: "token",
+			params:     form,
+			expected:   http.StatusOK,
+		},
+		{
+			name:       "forged",
+			validator:  NewRequestValidator("token"),
+			target:     "http://example.com/sms",
+			body:       form.Encode(),
+			signedURL:  "http://example.com/sms",
+			signedWith: "guess",
+			params:     form,
+			expected:   http.StatusForbidden,
+		},
+		{
+			name:       "tampered body",
+			validator:  NewRequestValidator("token"),
+			target:     "http://example.com/sms",
+			body:       url.Values{"From": {"+13065551234"}, "Body": {"bye"}}.Encode(),
+			signedURL:  "http://example.com/sms",
+			signedWith: "token",
+			params:     form,
+			expected:   http.StatusForbidden,
+		},
+		{
+			name:      "missing signature",
+			validator: NewRequestValidator("token"),
+			target:    "http://example.com/sms",
+			body:      form.Encode(),
+			expected:  http.StatusForbidden,
+		},
+		{
+			name:       "rotated token",
+			validator:  NewRequestValidator("new", ValidatorTokens("old")),
+			target:     "http://example.com/sms",
+			body:       form.Encode(),
+			signedURL:  "http://example.com/sms",
+			signedWith: "old",
+			params:     form,
+			expected:   http.StatusOK,
+		},
+		{
+			name:       "base url",
+			validator:  NewRequestValidator("token", ValidatorBaseURL("https://public.example.com")),
+			target:     "http://10.0.0.1:8080/sms",
+			body:       form.Encode(),
+			signedURL:  "https://public.example.com/sms",
+			signedWith: "token",
+			params:     form,
+			expected:   http.StatusOK,
+		},
+		{
+			name:      "forwarded headers",
+			validator: NewRequestValidator("token", ValidatorTrustForwardedHeaders()),
+			target:    "http://10.0.0.1:8080/sms",
+			headers: map[string]string{
+				"X-Forwarded-Proto": "https, http",
+				"X-Forwarded-Host":  "public.example.com",
+				"X-Forwarded-Port":  "8443",
+			},
+			body:       form.Encode(),
+			signedURL:  "https://public.example.com:8443/sms",
+			signedWith: "token",
+			params:     form,
+			expected:   http.StatusOK,
+		},
+		{
+			name:      "forwarded headers not trusted",
+			validator: NewRequestValidator("token"),
+			target:    "http://10.0.0.1:8080/sms",
+			headers: map[string]string{
+				"X-Forwarded-Proto": "https",
+				"X-Forwarded-Host":  "public.example.com",
+			},
+			body:       form.Encode(),
+			signedURL:  "https://public.example.com/sms",
+			signedWith: "token",
+			params:     form,
+			expected:   http.StatusForbidden,
+		},
+		{
+			name:        "json body",
+			validator:   NewRequestValidator("token"),
+			target:      "http://example.com/events" + jsonQuery,
+			contentType: "application/json",
+			body:        jsonBody,
+			signedURL:   "http://example.com/events" + jsonQuery,
+			signedWith:  "token",
+			expected:    http.StatusOK,
+		},
+		{
+			name:        "tampered json body",
+			validator:   NewRequestValidator("token"),
+			target:      "http://example.com/events" + jsonQuery,
+			contentType: "application/json",
+			body:        `{"property": "other"}`,
+			signedURL:   "http://example.com/events" + jsonQuery,
+			signedWith:  "token",
+			expected:    http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received string
+			handler := tt.validator.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := ioutil.ReadAll(r.Body)
+				received = string(b)
+			}))
+
+			r := httptest.NewRequest("POST", tt.target, strings.NewReader(tt.body))
+			if tt.contentType == "" {
+				tt.contentType = "application/x-www-form-urlencoded"
+			}
+			r.Header.Set("Content-Type", tt.contentType)
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+			if tt.signedWith != "" {
+				r.Header.Set(SignatureHeader, computeSignature(tt.signedWith, tt.signedURL, tt.params))
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, tt.expected, w.Code)
+			if tt.expected == http.StatusOK {
+				assert.Equal(t, tt.body, received)
+			}
+		})
+	}
+}
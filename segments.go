@@ -0,0 +1,70 @@
+package vtwilio
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Encoding is the character encoding a carrier uses for an SMS body
+type Encoding string
+
+const (
+	// GSM7 is the default 7 bit GSM alphabet
+	GSM7 Encoding = "GSM-7"
+	// UCS2 is used whenever a body contains a character outside of the GSM alphabet
+	UCS2 Encoding = "UCS-2"
+)
+
+const (
+	gsmSingleSegment  = 160
+	gsmMultiSegment   = 153
+	ucsSingleSegment  = 70
+	ucsMultiSegment   = 67
+	defaultMaxSegment = 10
+)
+
+const gsmBasic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞ\x1bÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+const gsmExtended = "^{}\\[~]|€\f"
+
+// Segments describes how a message body will be split when it is sent
+type Segments struct {
+	Encoding Encoding
+	// Units is the number of GSM septets or UCS-2 code units in the body
+	Units    int
+	Segments int
+}
+
+// CountSegments calculates how many SMS segments a message body will be sent as
+func CountSegments(body string) Segments {
+	if body == "" {
+		return Segments{Encoding: GSM7}
+	}
+
+	septets := 0
+	gsm := true
+	for _, r := range body {
+		if strings.ContainsRune(gsmBasic, r) {
+			septets++
+		} else if strings.ContainsRune(gsmExtended, r) {
+			septets += 2
+		} else {
+			gsm = false
+			break
+		}
+	}
+
+	if gsm {
+		return Segments{Encoding: GSM7, Units: septets, Segments: segmentCount(septets, gsmSingleSegment, gsmMultiSegment)}
+	}
+	units := len(utf16.Encode([]rune(body)))
+	return Segments{Encoding: UCS2, Units: units, Segments: segmentCount(units, ucsSingleSegment, ucsMultiSegment)}
+}
+
+func segmentCount(units, single, multi int) int {
+	if units <= single {
+		return 1
+	}
+	return (units + multi - 1) / multi
+}
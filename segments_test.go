@@ -0,0 +1,30 @@
+package vtwilio
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountSegments(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		expected Segments
+	}{
+		{"empty", "", Segments{Encoding: GSM7}},
+		{"short gsm", "Hello world", Segments{Encoding: GSM7, Units: 11, Segments: 1}},
+		{"full gsm segment", strings.Repeat("a", 160), Segments{Encoding: GSM7, Units: 160, Segments: 1}},
+		{"two gsm segments", strings.Repeat("a", 161), Segments{Encoding: GSM7, Units: 161, Segments: 2}},
+		{"extended characters count twice", strings.Repeat("€", 80), Segments{Encoding: GSM7, Units: 160, Segments: 1}},
+		{"unicode", "Hello 👋", Segments{Encoding: UCS2, Units: 8, Segments: 1}},
+		{"two unicode segments", strings.Repeat("ç", 71), Segments{Encoding: UCS2, Units: 71, Segments: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CountSegments(tt.in))
+		})
+	}
+}
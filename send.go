@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // SendMessage Sends a twilio message and returns the twilio message SID
@@ -15,24 +16,93 @@ func (v *VTwilio) SendMessage(message string, to string, opts ...SendOption) (*M
 	if to == "" {
 		return nil, fmt.Errorf("must contain a phone number to send the message to")
 	}
+	to, err := v.normalizeNumber(to)
+	if err != nil {
+		return nil, err
+	}
 	config := &sendConfiguration{}
 	for _, o := range opts {
 		o(config)
 	}
+	if v.quietHours != nil && !config.IgnoreQuietHours && config.SendAt.IsZero() {
+		if err := v.quietHours.apply(to, config); err != nil {
+			return nil, err
+		}
+	}
 
+	if v.pool != nil && config.From == "" && config.MessagingServiceSID == "" {
+		return v.sendFromPool(message, to, config)
+	}
 	return v.sendMessage(message, to, config)
 }
 
+// sendFromPool sends from the pool's choice of number and reports the outcome back to the pool
+func (v *VTwilio) sendFromPool(message, to string, config *sendConfiguration) (*Message, error) {
+	from, err := v.pool.Choose(to)
+	if err != nil {
+		return nil, err
+	}
+	config.From = from
+
+	req, err := v.messageRequest(message, to, config)
+	if err != nil {
+		return nil, err
+	}
+	m, rejection, err := handleMessageRejection(req)
+	if err != nil {
+		if rejection != nil && senderRejections[rejection.Code] {
+			v.pool.Failed(from)
+		}
+		return nil, err
+	}
+	v.pool.Succeeded(from)
+	return m, nil
+}
+
+// senderRejections are the Twilio error codes that are the sending number's fault, other errors such as a
+// bad recipient or Twilio being unreachable do not count against the number
+var senderRejections = map[int]bool{
+	21212: true, // invalid From number
+	21606: true, // From number can not send to this recipient
+	21611: true, // From number has too many queued messages
+	21659: true, // From is not a Twilio number
+	21660: true, // From number does not belong to the account
+}
+
 func (v *VTwilio) sendMessage(message, to string, config *sendConfiguration) (*Message, error) {
+	req, err := v.messageRequest(message, to, config)
+	if err != nil {
+		return nil, err
+	}
+	return handleMessage(req)
+}
+
+func (v *VTwilio) messageRequest(message, to string, config *sendConfiguration) (*http.Request, error) {
 	from := v.twilioNumber
 	if config.From != "" {
 		from = config.From
 	}
+	if from != "" {
+		n, err := v.normalizeNumber(from)
+		if err != nil {
+			return nil, err
+		}
+		from = n
+	}
 
 	values := url.Values{}
 	values.Set("To", to)
-	values.Set("From", from)
+	if from != "" {
+		values.Set("From", from)
+	}
 	values.Set("Body", message)
+	if config.MessagingServiceSID != "" {
+		values.Set("MessagingServiceSid", config.MessagingServiceSID)
+	}
+	if !config.SendAt.IsZero() {
+		values.Set("SendAt", config.SendAt.UTC().Format(time.RFC3339))
+		values.Set("ScheduleType", "fixed")
+	}
 	if config.MediaURL != "" {
 		values.Set("MediaUrl", config.MediaURL)
 	}
@@ -49,5 +119,5 @@ func (v *VTwilio) sendMessage(message, to string, config *sendConfiguration) (*M
 		return nil, err
 	}
 	setUpRequest(req, v.accountSID, v.authToken)
-	return handleMessage(req)
+	return req, nil
 }
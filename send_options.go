@@ -1,10 +1,15 @@
 package vtwilio
 
+import "time"
+
 type sendConfiguration struct {
-	MediaURL       string
-	From           string
-	CallbackURL    string
-	CallbackMethod Method
+	MediaURL            string
+	From                string
+	CallbackURL         string
+	CallbackMethod      Method
+	MessagingServiceSID string
+	SendAt              time.Time
+	IgnoreQuietHours    bool
 }
 
 // SendOption is an option for messages being sent
@@ -32,3 +37,26 @@ func Callback(url string, method Method) SendOption {
 		c.CallbackMethod = method
 	}
 }
+
+// MessagingServiceSID sends the message through a messaging service instead of a single number
+func MessagingServiceSID(sid string) SendOption {
+	return func(c *sendConfiguration) {
+		c.MessagingServiceSID = sid
+	}
+}
+
+// SendAt schedules the message to be sent at a later time. Twilio requires a messaging service
+// for scheduled messages and the time must be between 15 minutes and 35 days in the future.
+func SendAt(t time.Time) SendOption {
+	return func(c *sendConfiguration) {
+		c.SendAt = t
+	}
+}
+
+// IgnoreQuietHours sends the message even if the client has a quiet hours policy,
+// use it for transactional messages such as one time passcodes
+func IgnoreQuietHours() SendOption {
+	return func(c *sendConfiguration) {
+		c.IgnoreQuietHours = true
+	}
+}
@@ -24,41 +24,41 @@ func TestSendRequest(t *testing.T) {
 			name:         "no options",
 			opts:         []SendOption{},
 			message:      "Text message",
-			to:           "+09876543210",
+			to:           "+19876543210",
 			expectedPath: "/sid/Messages.json",
-			expectedBody: "Body=Text+message&From=%2B12345678910&To=%2B09876543210",
+			expectedBody: "Body=Text+message&From=%2B12345678910&To=%2B19876543210",
 		},
 		{
 			name:         "override from",
 			opts:         []SendOption{FromNumber("+10987654321")},
 			message:      "Text message",
-			to:           "+09876543210",
+			to:           "+19876543210",
 			expectedPath: "/sid/Messages.json",
-			expectedBody: "Body=Text+message&From=%2B10987654321&To=%2B09876543210",
+			expectedBody: "Body=Text+message&From=%2B10987654321&To=%2B19876543210",
 		},
 		{
 			name:         "media url",
 			opts:         []SendOption{MediaURL("http://url.com")},
 			message:      "Text message",
-			to:           "+09876543210",
+			to:           "+19876543210",
 			expectedPath: "/sid/Messages.json",
-			expectedBody: "Body=Text+message&From=%2B12345678910&MediaUrl=http%3A%2F%2Furl.com&To=%2B09876543210",
+			expectedBody: "Body=Text+message&From=%2B12345678910&MediaUrl=http%3A%2F%2Furl.com&To=%2B19876543210",
 		},
 		{
 			name:         "callback url",
 			opts:         []SendOption{Callback("http://url.com/callback", POST)},
 			message:      "Text message",
-			to:           "+09876543210",
+			to:           "+19876543210",
 			expectedPath: "/sid/Messages.json",
-			expectedBody: "Body=Text+message&From=%2B12345678910&StatusCallbackMethod=POST&To=%2B09876543210&statusCallback=http%3A%2F%2Furl.com%2Fcallback",
+			expectedBody: "Body=Text+message&From=%2B12345678910&StatusCallbackMethod=POST&To=%2B19876543210&statusCallback=http%3A%2F%2Furl.com%2Fcallback",
 		},
 		{
 			name:         "meda url and callback url",
 			opts:         []SendOption{MediaURL("http://url.com"), Callback("http://url.com/callback", POST)},
 			message:      "Text message",
-			to:           "+09876543210",
+			to:           "+19876543210",
 			expectedPath: "/sid/Messages.json",
-			expectedBody: "Body=Text+message&From=%2B12345678910&MediaUrl=http%3A%2F%2Furl.com&StatusCallbackMethod=POST&To=%2B09876543210&statusCallback=http%3A%2F%2Furl.com%2Fcallback",
+			expectedBody: "Body=Text+message&From=%2B12345678910&MediaUrl=http%3A%2F%2Furl.com&StatusCallbackMethod=POST&To=%2B19876543210&statusCallback=http%3A%2F%2Furl.com%2Fcallback",
 		},
 	}
 	for _, tt := range tests {
@@ -185,7 +185,7 @@ func TestHandlesResponse(t *testing.T) {
 	}{
 		{"no message", "", "+12345678910", nil, fmt.Errorf("must contain a message")},
 		{"no message", "Message", "", nil, fmt.Errorf("must contain a phone number to send the message to")},
-		{"valid request", "message", "+123445678910", expected, nil},
+		{"valid request", "message", "+12344567891", expected, nil},
 	}
 
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,89 @@
+package vtwilio
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"sort"
+)
+
+// SignatureHeader is the header Twilio signs its webhook requests with
+const SignatureHeader = "X-Twilio-Signature"
+
+// computeSignature signs a webhook request the way Twilio does, the full url followed by each
+// POST parameter name and value sorted by name, hashed with HMAC-SHA1 and base64 encoded
+func computeSignature(authToken, urlStr string, params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(urlStr))
+	for _, k := range keys {
+		values := append([]string{}, params[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			mac.Write([]byte(k + v))
+		}
+	}
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateRequest reports whether signature is a valid X-Twilio-Signature for a form encoded webhook
+// request to urlStr with params. urlStr must be the url Twilio requested, including the query string.
+// The url is also tried with the default port added or removed because Twilio may sign either form.
+func ValidateRequest(authToken, urlStr string, params url.Values, signature string) bool {
+	for _, u := range signatureURLs(urlStr) {
+		if hmac.Equal([]byte(computeSignature(authToken, u, params)), []byte(signature)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRequestBody reports whether signature is a valid X-Twilio-Signature for a webhook request with
+// a JSON body. Twilio signs the url, which carries a bodySHA256 query parameter with the hash of the body.
+func ValidateRequestBody(authToken, urlStr string, body []byte, signature string) bool {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return false
+	}
+	expected := u.Query().Get("bodySHA256")
+	if expected == "" {
+		return false
+	}
+	sum := sha256.Sum256(body)
+	if !hmac.Equal([]byte(hex.EncodeToString(sum[:])), []byte(expected)) {
+		return false
+	}
+	return ValidateRequest(authToken, urlStr, nil, signature)
+}
+
+// signatureURLs returns urlStr along with the same url with its default port removed or added
+func signatureURLs(urlStr string) []string {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return []string{urlStr}
+	}
+	port := "443"
+	if u.Scheme == "http" {
+		port = "80"
+	}
+
+	alt := *u
+	switch u.Port() {
+	case "":
+		alt.Host = net.JoinHostPort(u.Hostname(), port)
+	case port:
+		alt.Host = u.Hostname()
+	default:
+		return []string{urlStr}
+	}
+	return []string{urlStr, alt.String()}
+}
@@ -0,0 +1,60 @@
+package vtwilio
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeSignature(t *testing.T) {
+	// Example from Twilio's webhook security documentation
+	params := map[string][]string{
+		"CallSid": {"CA1234567890ABCDE"},
+		"Caller":  {"+12349013030"},
+		"Digits":  {"1234"},
+		"From":    {"+12349013030"},
+		"To":      {"+18005551212"},
+	}
+	actual := computeSignature("12345", "https://mycompany.com/myapp.php?foo=1&bar=2", params)
+	assert.Equal(t, "0/KCTR6DLpKmkAf8muzZqo1nDgQ=", actual)
+}
+
+func TestValidateRequest(t *testing.T) {
+	params := url.Values{"From": {"+13065551234"}, "Body": {"hi"}}
+	signature := computeSignature("token", "https://example.com/sms?x=1", params)
+
+	tests := []struct {
+		name      string
+		token     string
+		url       string
+		params    url.Values
+		signature string
+		expected  bool
+	}{
+		{name: "valid", token: "token", url: "https://example.com/sms?x=1", params: params, signature: signature, expected: true},
+		{name: "default port added", token: "token", url: "https://example.com:443/sms?x=1", params: params, signature: signature, expected: true},
+		{name: "other port", token: "token", url: "https://example.com:8443/sms?x=1", params: params, signature: signature, expected: false},
+		{name: "wrong token", token: "other", url: "https://example.com/sms?x=1", params: params, signature: signature, expected: false},
+		{name: "changed url", token: "token", url: "https://example.com/sms?x=2", params: params, signature: signature, expected: false},
+		{name: "changed params", token: "token", url: "https://example.com/sms?x=1", params: url.Values{"From": {"+13065551234"}, "Body": {"bye"}}, signature: signature, expected: false},
+		{name: "no signature", token: "token", url: "https://example.com/sms?x=1", params: params, signature: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ValidateRequest(tt.token, tt.url, tt.params, tt.signature))
+		})
+	}
+}
+
+func TestValidateRequestBody(t *testing.T) {
+	body := []byte(`{"property": "value", "boolean": true}`)
+	// sha256 of body
+	u := "https://example.com/myapp?bodySHA256=0a1ff7634d9ab3b95db5c9a2dfe9416e41502b283a80c7cf19632632f96e6620"
+	signature := computeSignature("12345", u, nil)
+
+	assert.True(t, ValidateRequestBody("12345", u, body, signature))
+	assert.False(t, ValidateRequestBody("12345", u, []byte(`{"property": "other"}`), signature))
+	assert.False(t, ValidateRequestBody("12345", "https://example.com/myapp", body, computeSignature("12345", "https://example.com/myapp", nil)))
+}
@@ -0,0 +1,243 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const defaultStatusRetention = 24 * time.Hour
+
+// MessageStatus is the delivery status of a message
+type MessageStatus string
+
+const (
+	// StatusAccepted the message was accepted by a messaging service
+	StatusAccepted MessageStatus = "accepted"
+	// StatusScheduled the message is scheduled to be sent later
+	StatusScheduled MessageStatus = "scheduled"
+	// StatusQueued the message is queued to be sent
+	StatusQueued MessageStatus = "queued"
+	// StatusSending the message is being sent
+	StatusSending MessageStatus = "sending"
+	// StatusSent the carrier accepted the message
+	StatusSent MessageStatus = "sent"
+	// StatusDelivered the carrier delivered the message
+	StatusDelivered MessageStatus = "delivered"
+	// StatusUndelivered the carrier could not deliver the message
+	StatusUndelivered MessageStatus = "undelivered"
+	// StatusFailed the message could not be sent
+	StatusFailed MessageStatus = "failed"
+	// StatusCanceled the scheduled message was canceled
+	StatusCanceled MessageStatus = "canceled"
+	// StatusPartiallyDelivered some parts of a multi part message were delivered
+	StatusPartiallyDelivered MessageStatus = "partially_delivered"
+	// StatusReceiving an inbound message is being received
+	StatusReceiving MessageStatus = "receiving"
+	// StatusReceived an inbound message was received
+	StatusReceived MessageStatus = "received"
+	// StatusRead the recipient read the message, only for channels with read receipts
+	StatusRead MessageStatus = "read"
+)
+
+// finalStatusRank is the rank of the statuses a message ends in, only the first of them is kept
+const finalStatusRank = 4
+
+// statusOrder ranks statuses in the order a message moves through them, a callback with a
+// lower rank than the last one seen for the message arrived out of order
+var statusOrder = map[MessageStatus]int{
+	StatusAccepted:           0,
+	StatusScheduled:          0,
+	StatusQueued:             1,
+	StatusSending:            2,
+	StatusReceiving:          2,
+	StatusSent:               3,
+	StatusDelivered:          finalStatusRank,
+	StatusPartiallyDelivered: finalStatusRank,
+	StatusUndelivered:        finalStatusRank,
+	StatusFailed:             finalStatusRank,
+	StatusCanceled:           finalStatusRank,
+	StatusReceived:           finalStatusRank,
+	StatusRead:               5,
+}
+
+// StatusEvent is a message status callback from Twilio
+type StatusEvent struct {
+	MessageSID    string
+	AccountSID    string
+	From          string
+	To            string
+	MessageStatus MessageStatus
+	// ErrorCode is 0 unless the message failed or was undelivered
+	ErrorCode int
+	// RawDlrDoneDate is the time the carrier reported delivery, in the carrier's YYMMDDhhmm format
+	RawDlrDoneDate string
+}
+
+// StatusCallbackHandler is an http.Handler for message status callbacks. Each new status is passed once to the
+// channel and then the registered funcs, repeated callbacks and statuses older than the last one seen are dropped.
+// A message's first final status, such as delivered or failed, is kept and later final statuses are dropped.
+type StatusCallbackHandler struct {
+	mu        sync.Mutex
+	last      map[string]seenStatus
+	funcs     []func(StatusEvent)
+	events    chan<- StatusEvent
+	retention time.Duration
+	pruned    time.Time
+	now       func() time.Time
+}
+
+type seenStatus struct {
+	status MessageStatus
+	at     time.Time
+}
+
+// StatusCallbackOption is an option for a status callback handler
+type StatusCallbackOption func(*StatusCallbackHandler)
+
+// OnStatus registers a func that is called with each new status event
+func OnStatus(f func(StatusEvent)) StatusCallbackOption {
+	return func(h *StatusCallbackHandler) {
+		h.funcs = append(h.funcs, f)
+	}
+}
+
+// StatusEvents sends each new status event to ch, the callback request waits until the event is received
+func StatusEvents(ch chan<- StatusEvent) StatusCallbackOption {
+	return func(h *StatusCallbackHandler) {
+		h.events = ch
+	}
+}
+
+// StatusRetention is how long the last status of a message is remembered, defaults to 24 hours
+func StatusRetention(d time.Duration) StatusCallbackOption {
+	return func(h *StatusCallbackHandler) {
+		h.retention = d
+	}
+}
+
+// StatusClock overrides the clock used to forget old statuses
+func StatusClock(now func() time.Time) StatusCallbackOption {
+	return func(h *StatusCallbackHandler) {
+		h.now = now
+	}
+}
+
+// NewStatusCallbackHandler returns a handler for message status callbacks
+func NewStatusCallbackHandler(opts ...StatusCallbackOption) *StatusCallbackHandler {
+	h := &StatusCallbackHandler{
+		last:      map[string]seenStatus{},
+		retention: defaultStatusRetention,
+		now:       time.Now,
+	}
+	for _, o := range opts {
+		o(h)
+	}
+	return h
+}
+
+// ParseStatusEvent parses the parameters of a status callback request
+func ParseStatusEvent(r *http.Request) (*StatusEvent, error) {
+	if r.Method != "POST" && r.Method != "GET" {
+		return nil, fmt.Errorf("unsupported method %v", r.Method)
+	}
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("invalid status parameters: %v", err)
+	}
+
+	e := &StatusEvent{
+		MessageSID:     r.Form.Get("MessageSid"),
+		AccountSID:     r.Form.Get("AccountSid"),
+		From:           r.Form.Get("From"),
+		To:             r.Form.Get("To"),
+		MessageStatus:  MessageStatus(r.Form.Get("MessageStatus")),
+		RawDlrDoneDate: r.Form.Get("RawDlrDoneDate"),
+	}
+	if e.MessageSID == "" {
+		return nil, fmt.Errorf("MessageSid is required")
+	}
+	if _, ok := statusOrder[e.MessageStatus]; !ok {
+		return nil, fmt.Errorf("invalid MessageStatus %q", e.MessageStatus)
+	}
+	if s := r.Form.Get("ErrorCode"); s != "" {
+		code, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ErrorCode %v", s)
+		}
+		e.ErrorCode = code
+	}
+	return e, nil
+}
+
+// ServeHTTP handles a status callback request
+func (h *StatusCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e, err := ParseStatusEvent(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	previous, isNew := h.record(e)
+	if isNew {
+		if h.events != nil {
+			select {
+			case h.events <- *e:
+			case <-r.Context().Done():
+				// forget the status so Twilio's retry is not dropped as a repeat, the funcs have not run yet
+				h.forget(e, previous)
+				http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+				return
+			}
+		}
+		for _, f := range h.funcs {
+			f(*e)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// record remembers the status of an event and reports whether it is new, along with the status it replaced
+func (h *StatusCallbackHandler) record(e *StatusEvent) (*seenStatus, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	if now.Sub(h.pruned) >= time.Minute {
+		for sid, s := range h.last {
+			if now.Sub(s.at) > h.retention {
+				delete(h.last, sid)
+			}
+		}
+		h.pruned = now
+	}
+
+	last, ok := h.last[e.MessageSID]
+	if ok {
+		rank, lastRank := statusOrder[e.MessageStatus], statusOrder[last.status]
+		if last.status == e.MessageStatus || rank < lastRank || rank == finalStatusRank && lastRank == finalStatusRank {
+			return nil, false
+		}
+	}
+	h.last[e.MessageSID] = seenStatus{status: e.MessageStatus, at: now}
+	if !ok {
+		return nil, true
+	}
+	return &last, true
+}
+
+// forget restores the status an event replaced, unless a newer status has been recorded since
+func (h *StatusCallbackHandler) forget(e *StatusEvent, previous *seenStatus) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.last[e.MessageSID].status != e.MessageStatus {
+		return
+	}
+	if previous == nil {
+		delete(h.last, e.MessageSID)
+		return
+	}
+	h.last[e.MessageSID] = *previous
+}
@@ -0,0 +1,170 @@
+package vtwilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func statusRequest(params url.Values) *http.Request {
+	r := httptest.NewRequest("POST", "/status", strings.NewReader(params.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestParseStatusEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        url.Values
+		expected      *StatusEvent
+		expectedError error
+	}{
+		{
+			name: "delivered",
+			params: url.Values{
+				"MessageSid":     {"SM123"},
+				"AccountSid":     {"AC123"},
+				"From":           {"+13065551234"},
+				"To":             {"+13065554321"},
+				"MessageStatus":  {"delivered"},
+				"RawDlrDoneDate": {"2310191530"},
+			},
+			expected: &StatusEvent{
+				MessageSID:     "SM123",
+				AccountSID:     "AC123",
+				From:           "+13065551234",
+				To:             "+13065554321",
+				MessageStatus:  StatusDelivered,
+				RawDlrDoneDate: "2310191530",
+			},
+		},
+		{
+			name:     "error code",
+			params:   url.Values{"MessageSid": {"SM123"}, "MessageStatus": {"undelivered"}, "ErrorCode": {"30003"}},
+			expected: &StatusEvent{MessageSID: "SM123", MessageStatus: StatusUndelivered, ErrorCode: 30003},
+		},
+		{
+			name:          "missing sid",
+			params:        url.Values{"MessageStatus": {"sent"}},
+			expectedError: fmt.Errorf("MessageSid is required"),
+		},
+		{
+			name:          "unknown status",
+			params:        url.Values{"MessageSid": {"SM123"}, "MessageStatus": {"lost"}},
+			expectedError: fmt.Errorf(`invalid MessageStatus "lost"`),
+		},
+		{
+			name:          "bad error code",
+			params:        url.Values{"MessageSid": {"SM123"}, "MessageStatus": {"failed"}, "ErrorCode": {"x"}},
+			expectedError: fmt.Errorf("invalid ErrorCode x"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ParseStatusEvent(statusRequest(tt.params))
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestStatusCallbackHandler(t *testing.T) {
+	received := []string{}
+	events := make(chan StatusEvent, 10)
+	h := NewStatusCallbackHandler(
+		OnStatus(func(e StatusEvent) {
+			received = append(received, e.MessageSID+" "+string(e.MessageStatus))
+		}),
+		StatusEvents(events),
+	)
+
+	for _, c := range []struct {
+		sid    string
+		status MessageStatus
+	}{
+		{"SM1", StatusQueued},
+		{"SM1", StatusSent},
+		{"SM2", StatusSent},
+		{"SM1", StatusSent},
+		{"SM1", StatusSending},
+		{"SM1", StatusDelivered},
+		{"SM1", StatusQueued},
+		{"SM1", StatusFailed},
+		{"SM1", StatusRead},
+		{"SM3", StatusReceiving},
+		{"SM3", StatusReceived},
+		{"SM4", StatusPartiallyDelivered},
+		{"SM4", StatusDelivered},
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, statusRequest(url.Values{"MessageSid": {c.sid}, "MessageStatus": {string(c.status)}}))
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	}
+
+	assert.Equal(t, []string{"SM1 queued", "SM1 sent", "SM2 sent", "SM1 delivered", "SM1 read", "SM3 receiving", "SM3 received", "SM4 partially_delivered"}, received)
+	assert.Len(t, events, 8)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, statusRequest(url.Values{"MessageStatus": {"sent"}}))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestStatusCallbackHandoffCanceled(t *testing.T) {
+	events := make(chan StatusEvent)
+	calls := 0
+	h := NewStatusCallbackHandler(StatusEvents(events), OnStatus(func(StatusEvent) { calls++ }))
+
+	send := func(status MessageStatus, ctx context.Context) int {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, statusRequest(url.Values{"MessageSid": {"SM1"}, "MessageStatus": {string(status)}}).WithContext(ctx))
+		return w.Code
+	}
+	receive := func() <-chan StatusEvent {
+		received := make(chan StatusEvent, 1)
+		go func() { received <- <-events }()
+		return received
+	}
+
+	received := receive()
+	assert.Equal(t, http.StatusNoContent, send(StatusSent, context.Background()))
+	assert.Equal(t, StatusSent, (<-received).MessageStatus)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, http.StatusServiceUnavailable, send(StatusDelivered, canceled))
+	assert.Equal(t, 1, calls, "funcs do not run for a callback that is not handed off")
+
+	received = receive()
+	assert.Equal(t, http.StatusNoContent, send(StatusDelivered, context.Background()), "the retry is not a repeat")
+	assert.Equal(t, StatusDelivered, (<-received).MessageStatus)
+	assert.Equal(t, 2, calls, "each status is passed to the funcs once")
+}
+
+func TestStatusCallbackRetention(t *testing.T) {
+	now := time.Date(2023, time.October, 19, 12, 0, 0, 0, time.UTC)
+	count := 0
+	h := NewStatusCallbackHandler(
+		OnStatus(func(StatusEvent) { count++ }),
+		StatusRetention(time.Hour),
+		StatusClock(func() time.Time { return now }),
+	)
+
+	send := func() {
+		h.ServeHTTP(httptest.NewRecorder(), statusRequest(url.Values{"MessageSid": {"SM1"}, "MessageStatus": {"sent"}}))
+	}
+	send()
+	send()
+	assert.Equal(t, 1, count)
+
+	now = now.Add(2 * time.Hour)
+	send()
+	assert.Equal(t, 2, count)
+}
@@ -0,0 +1,246 @@
+package vtwilio
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+)
+
+// TemplateRegistry holds named message templates with per locale variants
+type TemplateRegistry struct {
+	mu            sync.RWMutex
+	templates     map[string]map[string]*messageTemplate
+	fallbacks     map[string][]string
+	defaultLocale string
+	maxSegments   int
+	funcs         template.FuncMap
+}
+
+type messageTemplate struct {
+	tmpl     *template.Template
+	required []string
+}
+
+// TemplateRegistryOption is an option for a template registry
+type TemplateRegistryOption func(*TemplateRegistry)
+
+// DefaultLocale is the last locale tried when rendering a template, defaults to "en"
+func DefaultLocale(l string) TemplateRegistryOption {
+	return func(r *TemplateRegistry) {
+		r.defaultLocale = l
+	}
+}
+
+// LocaleFallback sets the locales tried, in order, when a template has no variant for locale
+func LocaleFallback(locale string, fallbacks ...string) TemplateRegistryOption {
+	return func(r *TemplateRegistry) {
+		r.fallbacks[locale] = fallbacks
+	}
+}
+
+// MaxSegments is the largest number of SMS segments a rendered template may use, defaults to 10
+func MaxSegments(n int) TemplateRegistryOption {
+	return func(r *TemplateRegistry) {
+		r.maxSegments = n
+	}
+}
+
+// TemplateFuncs adds functions that templates can call
+func TemplateFuncs(funcs template.FuncMap) TemplateRegistryOption {
+	return func(r *TemplateRegistry) {
+		for k, f := range funcs {
+			r.funcs[k] = f
+		}
+	}
+}
+
+// NewTemplateRegistry returns a new template registry
+func NewTemplateRegistry(opts ...TemplateRegistryOption) *TemplateRegistry {
+	r := &TemplateRegistry{
+		templates:     map[string]map[string]*messageTemplate{},
+		fallbacks:     map[string][]string{},
+		defaultLocale: "en",
+		maxSegments:   defaultMaxSegment,
+		funcs:         template.FuncMap{},
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// Register adds a locale variant of a named template. vars are the variables the template
+// needs, registration fails if the template uses a variable that is not listed and
+// rendering fails if one of them is missing from the data.
+func (r *TemplateRegistry) Register(name, locale, text string, vars ...string) error {
+	if name == "" {
+		return fmt.Errorf("template name is required")
+	}
+	if locale == "" {
+		return fmt.Errorf("template locale is required")
+	}
+
+	tmpl, err := template.New(name + "." + locale).Funcs(r.funcs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return err
+	}
+
+	declared := map[string]bool{}
+	for _, v := range vars {
+		declared[v] = true
+	}
+	for _, used := range templateFields(tmpl.Tree.Root) {
+		if !declared[used] {
+			return fmt.Errorf("template %v (%v) uses undeclared variable %v", name, locale, used)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.templates[name] == nil {
+		r.templates[name] = map[string]*messageTemplate{}
+	}
+	r.templates[name][locale] = &messageTemplate{tmpl: tmpl, required: vars}
+	return nil
+}
+
+// Render renders a named template using the best variant for locale
+func (r *TemplateRegistry) Render(name, locale string, data map[string]interface{}) (string, error) {
+	t, err := r.lookup(name, locale)
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range t.required {
+		if _, ok := data[v]; !ok {
+			return "", fmt.Errorf("template %v is missing variable %v", name, v)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (r *TemplateRegistry) lookup(name, locale string) (*messageTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	variants, ok := r.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("template %v is not registered", name)
+	}
+	for _, l := range r.localeChain(locale) {
+		if t, ok := variants[l]; ok {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("template %v has no variant for locale %v", name, locale)
+}
+
+// localeChain returns the locales to try for locale. Configured fallbacks come first,
+// then the locale with its subtags removed one at a time and finally the default locale.
+func (r *TemplateRegistry) localeChain(locale string) []string {
+	chain := []string{}
+	seen := map[string]bool{}
+	add := func(l string) {
+		if l != "" && !seen[l] {
+			seen[l] = true
+			chain = append(chain, l)
+		}
+	}
+
+	add(locale)
+	for _, l := range r.fallbacks[locale] {
+		add(l)
+	}
+	tag := strings.Replace(locale, "_", "-", -1)
+	for i := strings.LastIndex(tag, "-"); i > 0; i = strings.LastIndex(tag, "-") {
+		tag = tag[:i]
+		add(tag)
+	}
+	add(r.defaultLocale)
+	return chain
+}
+
+// templateFields returns the sorted top level fields a template reads from its data
+func templateFields(root parse.Node) []string {
+	found := map[string]bool{}
+	var walk func(n parse.Node, top bool)
+	walk = func(n parse.Node, top bool) {
+		switch n := n.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Nodes {
+				walk(c, top)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe, top)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Cmds {
+				walk(c, top)
+			}
+		case *parse.CommandNode:
+			for _, a := range n.Args {
+				walk(a, top)
+			}
+		case *parse.FieldNode:
+			if top {
+				found[n.Ident[0]] = true
+			}
+		case *parse.VariableNode:
+			if n.Ident[0] == "$" && len(n.Ident) > 1 {
+				found[n.Ident[1]] = true
+			}
+		case *parse.ChainNode:
+			walk(n.Node, top)
+		case *parse.IfNode:
+			walk(n.Pipe, top)
+			walk(n.List, top)
+			walk(n.ElseList, top)
+		case *parse.RangeNode:
+			walk(n.Pipe, top)
+			walk(n.List, false)
+			walk(n.ElseList, top)
+		case *parse.WithNode:
+			walk(n.Pipe, top)
+			walk(n.List, false)
+			walk(n.ElseList, top)
+		}
+	}
+	walk(root, true)
+
+	fields := make([]string, 0, len(found))
+	for f := range found {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// SendTemplated renders a registered template and sends it as a message
+func (v *VTwilio) SendTemplated(to, name, locale string, data map[string]interface{}, opts ...SendOption) (*Message, error) {
+	if v.templates == nil {
+		return nil, fmt.Errorf("no template registry configured")
+	}
+
+	body, err := v.templates.Render(name, locale, data)
+	if err != nil {
+		return nil, err
+	}
+	if s := CountSegments(body); s.Segments > v.templates.maxSegments {
+		return nil, fmt.Errorf("template %v renders to %v segments, the maximum is %v", name, s.Segments, v.templates.maxSegments)
+	}
+	return v.SendMessage(body, to, opts...)
+}
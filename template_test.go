@@ -0,0 +1,148 @@
+package vtwilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateRegister(t *testing.T) {
+	tests := []struct {
+		name, tmplName, locale, text string
+		vars                         []string
+		expectedError                error
+	}{
+		{
+			name:     "valid",
+			tmplName: "welcome", locale: "en", text: "Hi {{.Name}}",
+			vars: []string{"Name"},
+		},
+		{
+			name:     "undeclared variable",
+			tmplName: "welcome", locale: "en", text: "Hi {{.Name}}, your code is {{.Code}}",
+			vars:          []string{"Name"},
+			expectedError: fmt.Errorf("template welcome (en) uses undeclared variable Code"),
+		},
+		{
+			name:     "range body is not top level",
+			tmplName: "items", locale: "en", text: "{{range .Items}}{{.Title}} {{end}}",
+			vars: []string{"Items"},
+		},
+		{
+			name:     "root variable inside range",
+			tmplName: "items", locale: "en", text: "{{range .Items}}{{$.Name}} {{end}}",
+			vars:          []string{"Items"},
+			expectedError: fmt.Errorf("template items (en) uses undeclared variable Name"),
+		},
+		{
+			name:     "no name",
+			tmplName: "", locale: "en", text: "Hi",
+			expectedError: fmt.Errorf("template name is required"),
+		},
+		{
+			name:     "no locale",
+			tmplName: "welcome", locale: "", text: "Hi",
+			expectedError: fmt.Errorf("template locale is required"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewTemplateRegistry().Register(tt.tmplName, tt.locale, tt.text, tt.vars...)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+
+	t.Run("parse error", func(t *testing.T) {
+		err := NewTemplateRegistry().Register("broken", "en", "Hi {{.Name")
+		assert.Error(t, err)
+	})
+}
+
+func TestTemplateRender(t *testing.T) {
+	r := NewTemplateRegistry(LocaleFallback("pt-BR", "pt-PT"))
+	assert.NoError(t, r.Register("welcome", "en", "Hi {{.Name}}", "Name"))
+	assert.NoError(t, r.Register("welcome", "fr", "Bonjour {{.Name}}", "Name"))
+	assert.NoError(t, r.Register("welcome", "pt-PT", "Olá {{.Name}}", "Name"))
+
+	tests := []struct {
+		name, tmplName, locale string
+		data                   map[string]interface{}
+		expected               string
+		expectedError          error
+	}{
+		{"exact locale", "welcome", "fr", map[string]interface{}{"Name": "Ann"}, "Bonjour Ann", nil},
+		{"language fallback", "welcome", "fr-CA", map[string]interface{}{"Name": "Ann"}, "Bonjour Ann", nil},
+		{"configured fallback", "welcome", "pt-BR", map[string]interface{}{"Name": "Ann"}, "Olá Ann", nil},
+		{"default locale", "welcome", "de", map[string]interface{}{"Name": "Ann"}, "Hi Ann", nil},
+		{"missing variable", "welcome", "en", map[string]interface{}{}, "", fmt.Errorf("template welcome is missing variable Name")},
+		{"unknown template", "bye", "en", nil, "", fmt.Errorf("template bye is not registered")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := r.Render(tt.tmplName, tt.locale, tt.data)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestSendTemplated(t *testing.T) {
+	r := NewTemplateRegistry(MaxSegments(1))
+	assert.NoError(t, r.Register("welcome", "en", "Hi {{.Name}}", "Name"))
+
+	tests := []struct {
+		name          string
+		templates     *TemplateRegistry
+		data          map[string]interface{}
+		expectedBody  string
+		expectedError error
+	}{
+		{
+			name:         "renders and sends",
+			templates:    r,
+			data:         map[string]interface{}{"Name": "Ann"},
+			expectedBody: "Hi Ann",
+		},
+		{
+			name:          "too many segments",
+			templates:     r,
+			data:          map[string]interface{}{"Name": strings.Repeat("a", 200)},
+			expectedError: fmt.Errorf("template welcome renders to 2 segments, the maximum is 1"),
+		},
+		{
+			name:          "no registry",
+			expectedError: fmt.Errorf("no template registry configured"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := ""
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read body: %v", err)
+				}
+				form, _ := url.ParseQuery(string(b))
+				body = form.Get("Body")
+				w.Write([]byte(`{"sid": "sid"}`))
+			}))
+			defer ts.Close()
+
+			v := NewVTwilio("sid", "token", TwilioNumber("+12345678910"), Templates(tt.templates))
+			v.baseAPI = fmt.Sprintf("%s/", ts.URL)
+
+			_, err := v.SendTemplated("+14155551234", "welcome", "en", tt.data)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedBody, body)
+		})
+	}
+}
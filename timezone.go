@@ -0,0 +1,33 @@
+package vtwilio
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	// The zone database is embedded so lookups work on hosts without tzdata.
+	_ "time/tzdata"
+
+	"github.com/twiebe-va/vtwilio-go/phonenumber"
+)
+
+// TimezoneForNumber infers the likely time zone of an E.164 phone number.
+// North American numbers are resolved by area code, every other number by
+// its country calling code. A North American area code that is not known is
+// an error rather than a guess.
+func TimezoneForNumber(number string) (*time.Location, error) {
+	p, err := phonenumber.Parse(number, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if p.CountryCode == 1 {
+		if zone, ok := areaCodeZones[p.NationalNumber[:3]]; ok {
+			return time.LoadLocation(zone)
+		}
+	}
+	if zone, ok := countryCodeZones[strconv.Itoa(p.CountryCode)]; ok {
+		return time.LoadLocation(zone)
+	}
+	return nil, fmt.Errorf("unable to determine a time zone for %v", number)
+}
@@ -0,0 +1,269 @@
+package vtwilio
+
+// areaCodeZones maps a North American Numbering Plan area code to the IANA
+// time zone that covers most of it. Area codes that straddle a zone boundary
+// are assigned to the zone holding the majority of their subscribers.
+var areaCodeZones = map[string]string{}
+
+// nanpZones lists NANP area codes grouped by time zone, it is expanded into
+// areaCodeZones on init.
+var nanpZones = map[string][]string{
+	"America/New_York": {
+		// Connecticut, Delaware, District of Columbia
+		"203", "475", "860", "959", "302", "202",
+		// Florida
+		"239", "305", "321", "352", "386", "407", "448", "561", "656", "689",
+		"727", "754", "772", "786", "813", "863", "904", "941", "954",
+		// Georgia
+		"229", "404", "470", "478", "678", "706", "762", "770", "912", "943",
+		// Kentucky (east)
+		"502", "606", "859",
+		// Maine, Maryland, Massachusetts
+		"207", "240", "301", "410", "443", "667",
+		"339", "351", "413", "508", "617", "774", "781", "857", "978",
+		// New Hampshire, New Jersey
+		"603", "201", "551", "609", "640", "732", "848", "856", "862", "908", "973",
+		// New York
+		"212", "315", "332", "347", "516", "518", "585", "607", "631", "646",
+		"680", "716", "718", "838", "845", "914", "917", "929", "934",
+		// North Carolina
+		"252", "336", "704", "743", "828", "910", "919", "980", "984",
+		// Ohio
+		"216", "220", "234", "326", "330", "380", "419", "440", "513", "567",
+		"614", "740", "937",
+		// Pennsylvania
+		"215", "223", "267", "272", "412", "445", "484", "570", "582", "610",
+		"717", "724", "814", "835", "878",
+		// Rhode Island, South Carolina
+		"401", "803", "839", "843", "854", "864",
+		// Tennessee (east)
+		"423", "865",
+		// Vermont, Virginia, West Virginia
+		"802", "276", "434", "540", "571", "703", "757", "804", "826", "948",
+		"304", "681",
+	},
+	"America/Detroit": {
+		"231", "248", "269", "313", "517", "586", "616", "679", "734", "810",
+		"906", "947", "989",
+	},
+	"America/Indiana/Indianapolis": {
+		"260", "317", "463", "574", "765", "812", "930",
+	},
+	"America/Toronto": {
+		// Ontario
+		"226", "249", "289", "343", "365", "382", "416", "437", "519", "548",
+		"613", "647", "683", "705", "742", "753", "807", "905",
+		// Quebec
+		"263", "354", "367", "418", "438", "450", "468", "514", "579", "581",
+		"819", "873",
+	},
+	"America/Chicago": {
+		// Alabama
+		"205", "251", "256", "334", "659", "938",
+		// Arkansas
+		"327", "479", "501", "870",
+		// Florida panhandle
+		"850",
+		// Illinois
+		"217", "224", "309", "312", "331", "447", "464", "618", "630", "708",
+		"730", "773", "779", "815", "847", "861", "872",
+		// Indiana (northwest)
+		"219",
+		// Iowa
+		"319", "515", "563", "641", "712",
+		// Kansas
+		"316", "620", "785", "913",
+		// Kentucky (west)
+		"270", "364",
+		// Louisiana
+		"225", "318", "337", "504", "985",
+		// Minnesota
+		"218", "320", "507", "612", "651", "763", "952",
+		// Mississippi
+		"228", "601", "662", "769",
+		// Missouri
+		"314", "417", "557", "573", "636", "660", "816", "975",
+		// Nebraska, North Dakota, South Dakota
+		"308", "402", "531", "701", "605",
+		// Oklahoma
+		"405", "539", "572", "580", "918",
+		// Tennessee (middle and west)
+		"615", "629", "731", "901", "931",
+		// Texas
+		"210", "214", "254", "281", "325", "346", "361", "409", "430", "432",
+		"469", "512", "682", "713", "726", "737", "806", "817", "830", "832",
+		"903", "936", "940", "945", "956", "972", "979",
+		// Wisconsin
+		"262", "274", "353", "414", "534", "608", "715", "920",
+	},
+	"America/Winnipeg": {
+		"204", "431", "584",
+	},
+	"America/Regina": {
+		"306", "474", "639",
+	},
+	"America/Denver": {
+		// Colorado
+		"303", "719", "720", "970", "983",
+		// Idaho, Montana, New Mexico
+		"208", "986", "406", "505", "575",
+		// Texas (El Paso)
+		"915",
+		// Utah, Wyoming
+		"385", "435", "801", "307",
+	},
+	"America/Phoenix": {
+		"480", "520", "602", "623", "928",
+	},
+	"America/Edmonton": {
+		// Alberta
+		"368", "403", "587", "780", "825",
+		// Yukon, Northwest Territories, Nunavut
+		"867",
+	},
+	"America/Los_Angeles": {
+		// California
+		"209", "213", "279", "310", "323", "341", "350", "369", "408", "415",
+		"424", "442", "510", "530", "559", "562", "619", "626", "628", "650",
+		"657", "661", "669", "707", "714", "747", "760", "805", "818", "820",
+		"831", "840", "858", "909", "916", "925", "949", "951",
+		// Nevada
+		"702", "725", "775",
+		// Oregon
+		"458", "503", "541", "971",
+		// Washington
+		"206", "253", "360", "425", "509", "564",
+	},
+	"America/Vancouver": {
+		"236", "250", "257", "604", "672", "778",
+	},
+	"America/Anchorage": {
+		"907",
+	},
+	"Pacific/Honolulu": {
+		"808",
+	},
+	"America/Halifax": {
+		"428", "506", "782", "902",
+	},
+	"America/St_Johns": {
+		"709", "879",
+	},
+	"America/Puerto_Rico":   {"787", "939"},
+	"America/Nassau":        {"242"},
+	"America/Barbados":      {"246"},
+	"America/Anguilla":      {"264"},
+	"America/Antigua":       {"268"},
+	"America/Tortola":       {"284"},
+	"America/St_Thomas":     {"340"},
+	"America/Cayman":        {"345"},
+	"Atlantic/Bermuda":      {"441"},
+	"America/Grenada":       {"473"},
+	"America/Grand_Turk":    {"649"},
+	"America/Jamaica":       {"658", "876"},
+	"America/Montserrat":    {"664"},
+	"Pacific/Saipan":        {"670"},
+	"Pacific/Guam":          {"671"},
+	"Pacific/Pago_Pago":     {"684"},
+	"America/Lower_Princes": {"721"},
+	"America/St_Lucia":      {"758"},
+	"America/Dominica":      {"767"},
+	"America/St_Vincent":    {"784"},
+	"America/Santo_Domingo": {"809", "829", "849"},
+	"America/Port_of_Spain": {"868"},
+	"America/St_Kitts":      {"869"},
+}
+
+// countryCodeZones maps an ITU country calling code to the IANA time zone of
+// the country's capital or most populous region. Countries that span several
+// zones only get their primary zone. North America is left out because its
+// zone depends on the area code.
+var countryCodeZones = map[string]string{
+	"7":   "Europe/Moscow",
+	"20":  "Africa/Cairo",
+	"27":  "Africa/Johannesburg",
+	"30":  "Europe/Athens",
+	"31":  "Europe/Amsterdam",
+	"32":  "Europe/Brussels",
+	"33":  "Europe/Paris",
+	"34":  "Europe/Madrid",
+	"36":  "Europe/Budapest",
+	"39":  "Europe/Rome",
+	"40":  "Europe/Bucharest",
+	"41":  "Europe/Zurich",
+	"43":  "Europe/Vienna",
+	"44":  "Europe/London",
+	"45":  "Europe/Copenhagen",
+	"46":  "Europe/Stockholm",
+	"47":  "Europe/Oslo",
+	"48":  "Europe/Warsaw",
+	"49":  "Europe/Berlin",
+	"51":  "America/Lima",
+	"52":  "America/Mexico_City",
+	"53":  "America/Havana",
+	"54":  "America/Argentina/Buenos_Aires",
+	"55":  "America/Sao_Paulo",
+	"56":  "America/Santiago",
+	"57":  "America/Bogota",
+	"58":  "America/Caracas",
+	"60":  "Asia/Kuala_Lumpur",
+	"61":  "Australia/Sydney",
+	"62":  "Asia/Jakarta",
+	"63":  "Asia/Manila",
+	"64":  "Pacific/Auckland",
+	"65":  "Asia/Singapore",
+	"66":  "Asia/Bangkok",
+	"81":  "Asia/Tokyo",
+	"82":  "Asia/Seoul",
+	"84":  "Asia/Ho_Chi_Minh",
+	"86":  "Asia/Shanghai",
+	"90":  "Europe/Istanbul",
+	"91":  "Asia/Kolkata",
+	"92":  "Asia/Karachi",
+	"93":  "Asia/Kabul",
+	"94":  "Asia/Colombo",
+	"95":  "Asia/Yangon",
+	"98":  "Asia/Tehran",
+	"212": "Africa/Casablanca",
+	"213": "Africa/Algiers",
+	"216": "Africa/Tunis",
+	"233": "Africa/Accra",
+	"234": "Africa/Lagos",
+	"254": "Africa/Nairobi",
+	"255": "Africa/Dar_es_Salaam",
+	"256": "Africa/Kampala",
+	"351": "Europe/Lisbon",
+	"352": "Europe/Luxembourg",
+	"353": "Europe/Dublin",
+	"354": "Atlantic/Reykjavik",
+	"356": "Europe/Malta",
+	"357": "Asia/Nicosia",
+	"358": "Europe/Helsinki",
+	"359": "Europe/Sofia",
+	"370": "Europe/Vilnius",
+	"371": "Europe/Riga",
+	"372": "Europe/Tallinn",
+	"380": "Europe/Kiev",
+	"381": "Europe/Belgrade",
+	"385": "Europe/Zagreb",
+	"386": "Europe/Ljubljana",
+	"420": "Europe/Prague",
+	"421": "Europe/Bratislava",
+	"852": "Asia/Hong_Kong",
+	"853": "Asia/Macau",
+	"880": "Asia/Dhaka",
+	"886": "Asia/Taipei",
+	"965": "Asia/Kuwait",
+	"966": "Asia/Riyadh",
+	"971": "Asia/Dubai",
+	"972": "Asia/Jerusalem",
+	"974": "Asia/Qatar",
+}
+
+func init() {
+	for zone, codes := range nanpZones {
+		for _, c := range codes {
+			areaCodeZones[c] = zone
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package vtwilio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimezoneForNumber(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		expected      string
+		expectedError bool
+	}{
+		{name: "new york", in: "+12125551234", expected: "America/New_York"},
+		{name: "san francisco", in: "+14155551234", expected: "America/Los_Angeles"},
+		{name: "arizona", in: "+16025551234", expected: "America/Phoenix"},
+		{name: "formatted number", in: "+1 (808) 555-1234", expected: "Pacific/Honolulu"},
+		{name: "unknown area code", in: "+15555551234", expectedError: true},
+		{name: "united kingdom", in: "+447700900123", expected: "Europe/London"},
+		{name: "three digit country code", in: "+353861234567", expected: "Europe/Dublin"},
+		{name: "unknown country code", in: "+9991234567", expectedError: true},
+		{name: "missing plus", in: "12125551234", expectedError: true},
+		{name: "empty", in: "", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := TimezoneForNumber(tt.in)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual.String())
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package twiml
 
 import (
+	"bytes"
 	"encoding/xml"
+	"fmt"
 )
 
 // Interface for TwiML
@@ -14,11 +16,13 @@ type TwiML struct {
 	XMLName     xml.Name `xml:"Response"`
 	SayOpt      *Say     `xml:"Say,omitempty"`
 	MessageOpt  []string `xml:"Message,omitempty"`
+	GatherOpt   *Gather  `xml:"Gather,omitempty"`
 	RedirectOpt string   `xml:"Redirect,omitempty"`
 	DialOpt     *Dial    `xml:"Dial,omitempty"`
 	RejectOpt   *Reject  `xml:"Reject,omitempty"`
 	SMSOpt      *SMS     `xml:"Sms,omitempty"`
 	PauseOpt    *Pause   `xml:"Pause,omitempty"`
+	EnqueueOpt  *Enqueue `xml:"Enqueue,omitempty"`
 }
 
 // Method for http
@@ -44,3 +48,26 @@ func (t *TwiML) Build() ([]byte, error) {
 	}
 	return output, nil
 }
+
+// Parse reads a TwiML document, it fails if the document is not well formed XML or its root is not <Response>
+func Parse(data []byte) (*TwiML, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		root, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if root.Name.Local != "Response" || root.Name.Space != "" {
+			return nil, fmt.Errorf("root element is <%v>, not <Response>", root.Name.Local)
+		}
+		t := &TwiML{}
+		if err := d.DecodeElement(t, &root); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+}
@@ -1,6 +1,7 @@
 package twiml_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -150,6 +151,30 @@ func TestBuild(t *testing.T) {
 			),
 			expected: "<Response>\n	<Dial action=\"/action\" method=\"POST\" callerId=\"+123445678910\" record=\"record\" recordingStatusCallback=\"callback\">\n\t\t<Number>+12345678910</Number>\n\t</Dial>\n</Response>",
 		},
+		{
+			name: "dial queue",
+			in:   twiml.NewTwiML().Dial(twiml.DialQueue("support")),
+			expected: "<Response>\n	<Dial>\n\t\t<Queue>support</Queue>\n\t</Dial>\n</Response>",
+		},
+		{
+			name: "dial queue with url",
+			in:   twiml.NewTwiML().Dial(twiml.DialQueue("support", twiml.QueueURL("/about-to-connect"), twiml.QueueMethod(twiml.GET))),
+			expected: "<Response>\n	<Dial>\n\t\t<Queue url=\"/about-to-connect\" method=\"GET\">support</Queue>\n\t</Dial>\n</Response>",
+		},
+		{
+			name: "enqueue",
+			in:   twiml.NewTwiML().Enqueue("support"),
+			expected: "<Response>\n	<Enqueue>support</Enqueue>\n</Response>",
+		},
+		{
+			name: "enqueue all options",
+			in: twiml.NewTwiML().Enqueue("support",
+				twiml.EnqueueAction("/left"),
+				twiml.EnqueueMethod(twiml.POST),
+				twiml.EnqueueWaitURL("/hold-music"),
+				twiml.EnqueueWaitURLMethod(twiml.GET)),
+			expected: "<Response>\n	<Enqueue action=\"/left\" method=\"POST\" waitUrl=\"/hold-music\" waitUrlMethod=\"GET\">support</Enqueue>\n</Response>",
+		},
 		{
 			name: "reject",
 			in:   twiml.NewTwiML().Reject(),
@@ -165,6 +190,19 @@ func TestBuild(t *testing.T) {
 			in:   twiml.NewTwiML().Reject(twiml.RejectReason(twiml.Rejected)),
 			expected: "<Response>\n	<Reject reason=\"rejected\"></Reject>\n</Response>",
 		},
+		{
+			name: "gather",
+			in: twiml.NewTwiML().Say("Sorry").Gather(
+				twiml.GatherAction("/menu"),
+				twiml.GatherMethod(twiml.POST),
+				twiml.GatherInput(twiml.DTMF, twiml.Speech),
+				twiml.GatherTimeout(3),
+				twiml.GatherNumDigits(1),
+				twiml.GatherHints("sales", "support"),
+				twiml.GatherSay("Press 1 for sales", twiml.SayVoice(twiml.Alice)),
+			).Redirect("/menu"),
+			expected: "<Response>\n	<Say>Sorry</Say>\n	<Gather action=\"/menu\" method=\"POST\" input=\"dtmf speech\" timeout=\"3\" numDigits=\"1\" hints=\"sales, support\">\n		<Say voice=\"Alice\">Press 1 for sales</Say>\n	</Gather>\n	<Redirect>/menu</Redirect>\n</Response>",
+		},
 	}
 
 	for _, tt := range tests {
@@ -179,3 +217,63 @@ func TestBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		expected      *twiml.TwiML
+		expectedError bool
+	}{
+		{
+			name:     "message",
+			in:       `<?xml version="1.0" encoding="UTF-8"?><Response><Message>Hello</Message></Response>`,
+			expected: twiml.NewTwiML().Message("Hello"),
+		},
+		{
+			name:     "dial",
+			in:       `<Response><Say voice="alice">Connecting</Say><Dial callerId="+12345678910"><Number>+10987654321</Number></Dial></Response>`,
+			expected: twiml.NewTwiML().Say("Connecting", twiml.SayVoice("alice")).Dial(twiml.DialCallerID("+12345678910"), twiml.DialNumber("+10987654321")),
+		},
+		{
+			name:          "wrong root",
+			in:            `<html><body>Not found</body></html>`,
+			expectedError: true,
+		},
+		{
+			name:          "namespaced root",
+			in:            `<Response xmlns="urn:example"><Message>Hello</Message></Response>`,
+			expectedError: true,
+		},
+		{
+			name:          "empty",
+			in:            ``,
+			expectedError: true,
+		},
+		{
+			name:          "not xml",
+			in:            `{"message": "hello"}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := twiml.Parse([]byte(tt.in))
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, actual)
+				return
+			}
+			assert.NoError(t, err)
+			expected, err := tt.expected.Build()
+			assert.NoError(t, err)
+			rebuilt, err := actual.Build()
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), string(rebuilt))
+		})
+	}
+
+	_, err := twiml.Parse([]byte(`<Foo><Message>Hello</Message></Foo>`))
+	assert.Equal(t, fmt.Errorf("root element is <Foo>, not <Response>"), err)
+}
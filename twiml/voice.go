@@ -1,6 +1,9 @@
 package twiml
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"strings"
+)
 
 // Language for voices
 type Language string
@@ -62,6 +65,52 @@ type Dial struct {
 	Record                  string   `xml:"record,attr,omitempty"`
 	RecordingStatusCallback string   `xml:"recordingStatusCallback,attr,omitempty"`
 	Number                  string   `xml:",omitempty"`
+	Queue                   *Queue   `xml:"Queue,omitempty"`
+}
+
+// Queue is a queue noun that connects a dial to the call at the front of a queue
+type Queue struct {
+	XMLName xml.Name `xml:"Queue"`
+	URL     string   `xml:"url,attr,omitempty"`
+	Method  Method   `xml:"method,attr,omitempty"`
+	Name    string   `xml:",chardata"`
+}
+
+// Enqueue places the caller in a queue
+type Enqueue struct {
+	XMLName       xml.Name `xml:"Enqueue"`
+	Action        string   `xml:"action,attr,omitempty"`
+	Method        Method   `xml:"method,attr,omitempty"`
+	WaitURL       string   `xml:"waitUrl,attr,omitempty"`
+	WaitURLMethod Method   `xml:"waitUrlMethod,attr,omitempty"`
+	WorkflowSID   string   `xml:"workflowSid,attr,omitempty"`
+	Name          string   `xml:",chardata"`
+}
+
+// Input is a kind of input a gather collects
+type Input string
+
+const (
+	// DTMF collects digits pressed on the keypad
+	DTMF Input = "dtmf"
+	// Speech collects speech
+	Speech Input = "speech"
+)
+
+// Gather collects digits or speech from the caller and sends them to its action
+type Gather struct {
+	XMLName             xml.Name `xml:"Gather"`
+	Action              string   `xml:"action,attr,omitempty"`
+	Method              Method   `xml:"method,attr,omitempty"`
+	Input               string   `xml:"input,attr,omitempty"`
+	Timeout             int      `xml:"timeout,attr,omitempty"`
+	NumDigits           int      `xml:"numDigits,attr,omitempty"`
+	FinishOnKey         string   `xml:"finishOnKey,attr,omitempty"`
+	SpeechTimeout       string   `xml:"speechTimeout,attr,omitempty"`
+	Hints               string   `xml:"hints,attr,omitempty"`
+	Language            Language `xml:"language,attr,omitempty"`
+	ActionOnEmptyResult bool     `xml:"actionOnEmptyResult,attr,omitempty"`
+	Say                 *Say     `xml:"Say,omitempty"`
 }
 
 // Pause is the TwiML pause structure
@@ -168,6 +217,34 @@ func DialRecordingStatusCallback(u string) DialOption {
 	}
 }
 
+// DialQueue dials the call at the front of a queue
+func DialQueue(name string, opts ...QueueOption) DialOption {
+	return func(d *Dial) {
+		q := &Queue{Name: name}
+		for _, o := range opts {
+			o(q)
+		}
+		d.Queue = q
+	}
+}
+
+// QueueOption option for a dialed queue
+type QueueOption func(q *Queue)
+
+// QueueURL is TwiML played to the dequeued caller before they are connected
+func QueueURL(u string) QueueOption {
+	return func(q *Queue) {
+		q.URL = u
+	}
+}
+
+// QueueMethod method of the queue url
+func QueueMethod(m Method) QueueOption {
+	return func(q *Queue) {
+		q.Method = m
+	}
+}
+
 // Dial to a number
 func (t *TwiML) Dial(opts ...DialOption) *TwiML {
 	d := &Dial{}
@@ -198,3 +275,149 @@ func (t *TwiML) Reject(opts ...RejectOption) *TwiML {
 	t.RejectOpt = r
 	return t
 }
+
+// EnqueueOption option for placing a call in a queue
+type EnqueueOption func(e *Enqueue)
+
+// EnqueueAction is requested when the caller leaves the queue
+func EnqueueAction(a string) EnqueueOption {
+	return func(e *Enqueue) {
+		e.Action = a
+	}
+}
+
+// EnqueueMethod method of the action url
+func EnqueueMethod(m Method) EnqueueOption {
+	return func(e *Enqueue) {
+		e.Method = m
+	}
+}
+
+// EnqueueWaitURL is TwiML played to the caller while they wait
+func EnqueueWaitURL(u string) EnqueueOption {
+	return func(e *Enqueue) {
+		e.WaitURL = u
+	}
+}
+
+// EnqueueWaitURLMethod method of the wait url
+func EnqueueWaitURLMethod(m Method) EnqueueOption {
+	return func(e *Enqueue) {
+		e.WaitURLMethod = m
+	}
+}
+
+// EnqueueWorkflowSID routes the call with a TaskRouter workflow instead of a named queue
+func EnqueueWorkflowSID(sid string) EnqueueOption {
+	return func(e *Enqueue) {
+		e.WorkflowSID = sid
+	}
+}
+
+// Enqueue places the caller in the named queue, the queue is created if it does not exist
+func (t *TwiML) Enqueue(name string, opts ...EnqueueOption) *TwiML {
+	e := &Enqueue{Name: name}
+	for _, o := range opts {
+		o(e)
+	}
+	t.EnqueueOpt = e
+	return t
+}
+
+// GatherOption option for collecting input
+type GatherOption func(g *Gather)
+
+// GatherAction is requested with the Digits or SpeechResult the caller gave
+func GatherAction(a string) GatherOption {
+	return func(g *Gather) {
+		g.Action = a
+	}
+}
+
+// GatherMethod method of the action url
+func GatherMethod(m Method) GatherOption {
+	return func(g *Gather) {
+		g.Method = m
+	}
+}
+
+// GatherInput sets the kinds of input to collect, defaults to DTMF
+func GatherInput(inputs ...Input) GatherOption {
+	return func(g *Gather) {
+		kinds := make([]string, 0, len(inputs))
+		for _, i := range inputs {
+			kinds = append(kinds, string(i))
+		}
+		g.Input = strings.Join(kinds, " ")
+	}
+}
+
+// GatherTimeout seconds to wait for the caller to start and between digits, Twilio defaults to 5
+func GatherTimeout(seconds int) GatherOption {
+	return func(g *Gather) {
+		g.Timeout = seconds
+	}
+}
+
+// GatherNumDigits number of digits to collect before calling the action
+func GatherNumDigits(n int) GatherOption {
+	return func(g *Gather) {
+		g.NumDigits = n
+	}
+}
+
+// GatherFinishOnKey key that ends the input, Twilio defaults to #
+func GatherFinishOnKey(k string) GatherOption {
+	return func(g *Gather) {
+		g.FinishOnKey = k
+	}
+}
+
+// GatherSpeechTimeout seconds of silence that end speech input, or "auto"
+func GatherSpeechTimeout(t string) GatherOption {
+	return func(g *Gather) {
+		g.SpeechTimeout = t
+	}
+}
+
+// GatherHints words or phrases the caller is likely to say
+func GatherHints(hints ...string) GatherOption {
+	return func(g *Gather) {
+		g.Hints = strings.Join(hints, ", ")
+	}
+}
+
+// GatherLanguage language of the speech to recognise
+func GatherLanguage(l Language) GatherOption {
+	return func(g *Gather) {
+		g.Language = l
+	}
+}
+
+// GatherActionOnEmptyResult requests the action even when the caller gives no input
+func GatherActionOnEmptyResult() GatherOption {
+	return func(g *Gather) {
+		g.ActionOnEmptyResult = true
+	}
+}
+
+// GatherSay is said to the caller while waiting for input
+func GatherSay(message string, opts ...SayOption) GatherOption {
+	return func(g *Gather) {
+		s := &Say{Value: message}
+		for _, o := range opts {
+			o(s)
+		}
+		g.Say = s
+	}
+}
+
+// Gather collects input from the caller
+func (t *TwiML) Gather(opts ...GatherOption) *TwiML {
+	g := &Gather{}
+	for _, o := range opts {
+		o(g)
+	}
+	t.GatherOpt = g
+	return t
+}
@@ -0,0 +1,136 @@
+package vtwilio
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// minVanityRun is the fewest digits of a word a number must spell to match, a single digit
+// appears in almost every number
+const minVanityRun = 2
+
+// keypad maps letters to the digit they share a phone keypad button with
+var keypad = map[rune]rune{
+	'A': '2', 'B': '2', 'C': '2',
+	'D': '3', 'E': '3', 'F': '3',
+	'G': '4', 'H': '4', 'I': '4',
+	'J': '5', 'K': '5', 'L': '5',
+	'M': '6', 'N': '6', 'O': '6',
+	'P': '7', 'Q': '7', 'R': '7', 'S': '7',
+	'T': '8', 'U': '8', 'V': '8',
+	'W': '9', 'X': '9', 'Y': '9', 'Z': '9',
+}
+
+// VanityMatch is how well an available number spells a vanity word
+type VanityMatch struct {
+	Number AvailablePhoneNumberData
+	// Matched is the longest run of the word's keypad pattern found in the number
+	Matched int
+	// Full is true when the whole word is found in the number
+	Full bool
+	// Suffix is true when the match ends the number e.g. 1-800-555-PIZZA
+	Suffix bool
+}
+
+// KeypadPattern turns a word into the digits that spell it on a phone keypad e.g. "PIZZA" is "74992".
+// Digits and the * wildcard are kept, spaces and dashes are dropped.
+func KeypadPattern(word string) (string, error) {
+	var b strings.Builder
+	for _, r := range word {
+		switch {
+		case r >= '0' && r <= '9', r == '*':
+			b.WriteRune(r)
+		case r == ' ' || r == '-':
+		default:
+			d, ok := keypad[unicode.ToUpper(r)]
+			if !ok {
+				return "", fmt.Errorf("vanity word contains invalid character %q", r)
+			}
+			b.WriteRune(d)
+		}
+	}
+	if b.Len() == 0 {
+		return "", fmt.Errorf("vanity word is required")
+	}
+	return b.String(), nil
+}
+
+// RankVanity ranks numbers by how much of word they spell. Numbers that spell more of the
+// word come first and, for the same length, numbers that end with the match come first.
+// Numbers that spell less than two digits of the word, or the whole word when it is shorter, are left out.
+func RankVanity(word string, numbers []AvailablePhoneNumberData) ([]VanityMatch, error) {
+	pattern, err := KeypadPattern(word)
+	if err != nil {
+		return nil, err
+	}
+
+	minRun := minVanityRun
+	if len(pattern) < minRun {
+		minRun = len(pattern)
+	}
+
+	matches := []VanityMatch{}
+	for _, n := range numbers {
+		matched, suffix := longestRun(pattern, strings.TrimPrefix(n.PhoneNumber, "+"))
+		if matched < minRun {
+			continue
+		}
+		matches = append(matches, VanityMatch{
+			Number:  n,
+			Matched: matched,
+			Full:    matched == len(pattern),
+			Suffix:  suffix,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Matched != matches[j].Matched {
+			return matches[i].Matched > matches[j].Matched
+		}
+		return matches[i].Suffix && !matches[j].Suffix
+	})
+	return matches, nil
+}
+
+// longestRun finds the longest part of pattern that appears in number and whether
+// a run of that length can end the number. Wildcards in pattern match any digit.
+func longestRun(pattern, number string) (int, bool) {
+	best, suffix := 0, false
+	for start := 0; start < len(pattern); start++ {
+		for end := len(pattern); end-start >= best && end > start; end-- {
+			part := pattern[start:end]
+			if !containsPattern(number, part) {
+				continue
+			}
+			ends := matchesAt(number, part, len(number)-len(part))
+			if len(part) > best || (len(part) == best && ends && !suffix) {
+				best, suffix = len(part), ends
+			}
+			break
+		}
+	}
+	return best, suffix
+}
+
+func containsPattern(number, part string) bool {
+	for i := 0; i+len(part) <= len(number); i++ {
+		if matchesAt(number, part, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAt(number, part string, at int) bool {
+	if at < 0 || at+len(part) > len(number) {
+		return false
+	}
+	for i := 0; i < len(part); i++ {
+		if part[i] != '*' && part[i] != number[at+i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package vtwilio
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeypadPattern(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		expected      string
+		expectedError error
+	}{
+		{name: "word", in: "PIZZA", expected: "74992"},
+		{name: "lower case", in: "pizza", expected: "74992"},
+		{name: "digits and wildcards", in: "1-800-***-FLOWERS", expected: "1800***3569377"},
+		{name: "invalid character", in: "PIZZA!", expectedError: fmt.Errorf("vanity word contains invalid character '!'")},
+		{name: "empty", in: " - ", expectedError: fmt.Errorf("vanity word is required")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := KeypadPattern(tt.in)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRankVanity(t *testing.T) {
+	numbers := []AvailablePhoneNumberData{
+		{PhoneNumber: "+13065550000"},
+		{PhoneNumber: "+13067499255"},
+		{PhoneNumber: "+13065574992"},
+		{PhoneNumber: "+13067499211"},
+		{PhoneNumber: "+13065557490"},
+		{PhoneNumber: "+13065551701"},
+	}
+
+	actual, err := RankVanity("PIZZA", numbers)
+	assert.NoError(t, err)
+
+	ranked := []string{}
+	for _, m := range actual {
+		ranked = append(ranked, m.Number.PhoneNumber)
+	}
+	assert.Equal(t, []string{"+13065574992", "+13067499255", "+13067499211", "+13065557490"}, ranked)
+	assert.Equal(t, VanityMatch{Number: numbers[2], Matched: 5, Full: true, Suffix: true}, actual[0])
+	assert.Equal(t, VanityMatch{Number: numbers[1], Matched: 5, Full: true}, actual[1])
+	assert.Equal(t, VanityMatch{Number: numbers[4], Matched: 3}, actual[3])
+
+	single, err := RankVanity("P", numbers)
+	assert.NoError(t, err)
+	assert.Len(t, single, 5)
+
+	_, err = RankVanity("", numbers)
+	assert.Error(t, err)
+}
@@ -1,5 +1,11 @@
 package vtwilio
 
+import (
+	"context"
+	"io"
+	"time"
+)
+
 // Interface for VTwilio
 type Interface interface {
 	SetPhoneNumber(n string) *VTwilio
@@ -7,17 +13,68 @@ type Interface interface {
 	ListMessages(opts ...ListOption) (*List, error)
 	GetMessage(messageSID string) (*Message, error)
 	AvailablePhoneNumbers(countryCode string, opts ...AvailableOption) (*AvailablePhoneNumbers, error)
+	AvailableCountries() (*AvailableCountries, error)
 	IncomingPhoneNumber(number string, opts ...IncomingPhoneNumberOption) (*IncomingPhoneNumber, error)
 	UpdateIncomingPhoneNumber(number, sid string, opts ...IncomingPhoneNumberOption) (*IncomingPhoneNumber, error)
-	ReleaseNumber(sid string) error
+	ReleaseNumber(sid string, opts ...ReleaseOption) error
+	QuarantineNumber(sid string, grace time.Duration, opts ...ReleaseOption) (*IncomingPhoneNumber, error)
+	ReleaseQuarantined() (*QuarantineRelease, error)
+	ListIncomingPhoneNumbers(opts ...IncomingPhoneNumberListOption) (*IncomingPhoneNumberList, error)
+	GetIncomingPhoneNumber(sid string) (*IncomingPhoneNumber, error)
+	FindIncomingPhoneNumber(number string) (*IncomingPhoneNumber, error)
+	PlanNumbers(state *NumberState) (*NumbersPlan, error)
+	ApplyNumbersPlan(plan *NumbersPlan, opts ...ApplyOption) (*ApplyResult, error)
+	CheckWebhooks(opts ...WebhookCheckOption) (*WebhookReport, error)
+	ProvisionNumber(ctx context.Context, criteria NumberCriteria, config ...IncomingPhoneNumberOption) (*ProvisionReport, error)
+	SendTemplated(to, name, locale string, data map[string]interface{}, opts ...SendOption) (*Message, error)
+	LookupPhoneNumber(number string, opts ...LookupOption) (*Lookup, error)
+	MakeCall(to, from string, opts ...CallOption) (*Call, error)
+	GetCall(sid string) (*Call, error)
+	ListCalls(opts ...CallListOption) (*CallList, error)
+	RedirectCall(sid string, opts ...CallOption) (*Call, error)
+	EndCall(sid string) (*Call, error)
+	CancelCall(sid string) (*Call, error)
+	ListRecordings(opts ...RecordingListOption) (*RecordingList, error)
+	GetRecording(sid string) (*Recording, error)
+	DownloadRecording(sid string, format RecordingFormat, w io.Writer) error
+	DeleteRecording(sid string) error
+	ArchiveRecordings(dir string, cutoff time.Time, format RecordingFormat) (*RecordingArchive, error)
+	StartCallRecording(callSID string, opts ...CallRecordingOption) (*Recording, error)
+	PauseCallRecording(callSID, recordingSID string, opts ...PauseOption) (*Recording, error)
+	ResumeCallRecording(callSID, recordingSID string) (*Recording, error)
+	StopCallRecording(callSID, recordingSID string) (*Recording, error)
+	ListConferences(opts ...ConferenceListOption) (*ConferenceList, error)
+	GetConference(sid string) (*Conference, error)
+	EndConference(sid string) (*Conference, error)
+	ListParticipants(conferenceSID string, opts ...ParticipantListOption) (*ParticipantList, error)
+	AddParticipant(conferenceSID, to, from string, opts ...ParticipantOption) (*Participant, error)
+	MuteParticipant(conferenceSID, callSID string, muted bool) (*Participant, error)
+	HoldParticipant(conferenceSID, callSID string, hold bool, holdURL string) (*Participant, error)
+	CoachParticipant(conferenceSID, callSID, callSIDToCoach string) (*Participant, error)
+	RemoveParticipant(conferenceSID, callSID string) error
+	CreateQueue(friendlyName string, opts ...QueueOption) (*Queue, error)
+	UpdateQueue(sid string, opts ...QueueOption) (*Queue, error)
+	GetQueue(sid string) (*Queue, error)
+	DeleteQueue(sid string) error
+	ListQueues(opts ...QueueListOption) (*QueueList, error)
+	ListQueueMembers(queueSID string, opts ...QueueListOption) (*QueueMemberList, error)
+	GetQueueMember(queueSID, callSID string) (*QueueMember, error)
+	DequeueMember(queueSID, callSID, redirectURL string, method Method) (*QueueMember, error)
+	DequeueFront(queueSID, redirectURL string, method Method) (*QueueMember, error)
 }
 
 const (
 	baseAPI                  = "https://api.twilio.com/2010-04-01/Accounts/"
+	lookupAPI                = "https://lookups.twilio.com/v2/PhoneNumbers/"
 	messageAPI               = "/Messages"
 	availablePhoneNumbersAPI = "/AvailablePhoneNumbers"
 	incomingPhoneNumbersAPI  = "/IncomingPhoneNumbers"
-	local                    = "/Local"
+	callsAPI                 = "/Calls"
+	recordingsAPI            = "/Recordings"
+	conferencesAPI           = "/Conferences"
+	participantsAPI          = "/Participants"
+	queuesAPI                = "/Queues"
+	membersAPI               = "/Members"
 	tag                      = "vtwilio"
 )
 
@@ -27,6 +84,17 @@ type VTwilio struct {
 	authToken    string
 	twilioNumber string
 	baseAPI      string
+	quietHours   *QuietHours
+	templates    *TemplateRegistry
+	region       string
+	lookupAPI    string
+	lookupCache  *lookupCache
+	pool         *NumberPool
+	// protectedNumbers are numbers or sids ReleaseNumber refuses to release
+	protectedNumbers []string
+	protectedTag     string
+	// now is the clock used for quarantine release times
+	now func() time.Time
 }
 
 // List is a response from a get
@@ -94,21 +162,62 @@ type AvailablePhoneNumbers struct {
 	AvailablePhoneNumber []AvailablePhoneNumberData `json:"available_phone_numbers"`
 }
 
+// AvailableCountry is a country Twilio sells phone numbers in
+type AvailableCountry struct {
+	CountryCode     string            `json:"country_code"`
+	Country         string            `json:"country"`
+	Beta            bool              `json:"beta"`
+	URI             string            `json:"uri"`
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+	// NumberTypes are the types of number available in the country
+	NumberTypes []NumberType `json:"-"`
+}
+
+// AvailableCountries response from twilio
+type AvailableCountries struct {
+	URI       string              `json:"uri"`
+	Countries []*AvailableCountry `json:"countries"`
+}
+
 // IncomingPhoneNumber data from twilio
 type IncomingPhoneNumber struct {
-	SID                 string       `json:"sid"`
-	AccountSID          string       `json:"account_sid"`
-	FriendlyName        string       `json:"friendly_name"`
-	PhoneNumber         string       `json:"phone_number"`
-	VoiceURL            string       `json:"voice_url"`
-	VoiceMethod         string       `json:"voice_method"`
-	VoiceFallbackURL    string       `json:"voice_fallback_url"`
-	VoiceFallbackMethod string       `json:"voice_fallback_method"`
-	DateCreated         string       `json:"date_created"`
-	DateUpdated         string       `json:"date_updated"`
-	Capabilities        Capabilities `json:"capabilities"`
-	Beta                bool         `json:"beta"`
-	URI                 string       `json:"uri"`
+	SID                  string       `json:"sid"`
+	AccountSID           string       `json:"account_sid"`
+	FriendlyName         string       `json:"friendly_name"`
+	PhoneNumber          string       `json:"phone_number"`
+	VoiceURL             string       `json:"voice_url"`
+	VoiceMethod          string       `json:"voice_method"`
+	VoiceFallbackURL     string       `json:"voice_fallback_url"`
+	VoiceFallbackMethod  string       `json:"voice_fallback_method"`
+	DateCreated          string       `json:"date_created"`
+	DateUpdated          string       `json:"date_updated"`
+	Capabilities         Capabilities `json:"capabilities"`
+	Beta                 bool         `json:"beta"`
+	URI                  string       `json:"uri"`
+	SMSURL               string       `json:"sms_url"`
+	SMSMethod            string       `json:"sms_method"`
+	SMSFallbackURL       string       `json:"sms_fallback_url"`
+	SMSFallbackMethod    string       `json:"sms_fallback_method"`
+	SMSApplicationSID    string       `json:"sms_application_sid"`
+	VoiceApplicationSID  string       `json:"voice_application_sid"`
+	VoiceCallerIDLookup  bool         `json:"voice_caller_id_lookup"`
+	StatusCallback       string       `json:"status_callback"`
+	StatusCallbackMethod string       `json:"status_callback_method"`
+	TrunkSID             string       `json:"trunk_sid"`
+	AddressSID           string       `json:"address_sid"`
+	Origin               NumberOrigin `json:"origin"`
+	APIVersion           string       `json:"api_version"`
+}
+
+// IncomingPhoneNumberList is a page of the account's phone numbers
+type IncomingPhoneNumberList struct {
+	FirstPageURI         string                 `json:"first_page_uri"`
+	NextPageURI          string                 `json:"next_page_uri"`
+	PreviousPageURI      string                 `json:"previous_page_uri"`
+	Page                 int                    `json:"page"`
+	PageSize             int                    `json:"page_size"`
+	End                  int                    `json:"end"`
+	IncomingPhoneNumbers []*IncomingPhoneNumber `json:"incoming_phone_numbers"`
 }
 
 // Option options for vtwilio
@@ -121,6 +230,64 @@ func TwilioNumber(n string) Option {
 	}
 }
 
+// DefaultRegion is the region used to parse phone numbers that do not begin with +
+// e.g. with "US" the number "(415) 555-1234" is sent as "+14155551234"
+func DefaultRegion(region string) Option {
+	return func(v *VTwilio) {
+		v.region = region
+	}
+}
+
+// SenderPool sends each message from a number chosen by the pool unless FromNumber or MessagingServiceSID is used
+func SenderPool(p *NumberPool) Option {
+	return func(v *VTwilio) {
+		v.pool = p
+	}
+}
+
+// ProtectNumbers stops ReleaseNumber and QuarantineNumber from giving up the numbers, each one
+// may be a phone number or an incoming phone number sid
+func ProtectNumbers(numbersOrSIDs ...string) Option {
+	return func(v *VTwilio) {
+		v.protectedNumbers = append(v.protectedNumbers, numbersOrSIDs...)
+	}
+}
+
+// ProtectTag stops ReleaseNumber and QuarantineNumber from giving up numbers whose friendly name contains tag
+func ProtectTag(tag string) Option {
+	return func(v *VTwilio) {
+		v.protectedTag = tag
+	}
+}
+
+// QuarantineClock sets the clock used for quarantine release times, defaults to time.Now
+func QuarantineClock(now func() time.Time) Option {
+	return func(v *VTwilio) {
+		v.now = now
+	}
+}
+
+// QuietHoursPolicy applies a quiet hours policy to every message sent by the client
+func QuietHoursPolicy(q *QuietHours) Option {
+	return func(v *VTwilio) {
+		v.quietHours = q
+	}
+}
+
+// Templates sets the template registry used by SendTemplated
+func Templates(r *TemplateRegistry) Option {
+	return func(v *VTwilio) {
+		v.templates = r
+	}
+}
+
+// LookupCacheTTL caches Lookup API responses in memory for ttl so repeated lookups are not billed again
+func LookupCacheTTL(ttl time.Duration) Option {
+	return func(v *VTwilio) {
+		v.lookupCache = newLookupCache(ttl)
+	}
+}
+
 // NewVTwilio returns a new NewVTwilio instance
 func NewVTwilio(accountSID, authToken string, opts ...Option) *VTwilio {
 	v := &VTwilio{accountSID: accountSID, authToken: authToken}
@@ -133,6 +300,7 @@ func NewVTwilio(accountSID, authToken string, opts ...Option) *VTwilio {
 
 func setDefaults(v *VTwilio) {
 	v.baseAPI = baseAPI
+	v.lookupAPI = lookupAPI
 }
 
 // SetPhoneNumber sets the twilio phone number
@@ -19,12 +19,12 @@ func TestVTwilio(t *testing.T) {
 		{
 			name:     "empty vtwilio options",
 			in:       in{accountSID: "sid", authToken: "token", opts: []Option{}},
-			expected: &VTwilio{accountSID: "sid", authToken: "token", twilioNumber: "", baseAPI: baseAPI},
+			expected: &VTwilio{accountSID: "sid", authToken: "token", twilioNumber: "", baseAPI: baseAPI, lookupAPI: lookupAPI},
 		},
 		{
 			name:     "set number",
 			in:       in{accountSID: "sid", authToken: "token", opts: []Option{TwilioNumber("12345678910")}},
-			expected: &VTwilio{accountSID: "sid", authToken: "token", twilioNumber: "12345678910", baseAPI: baseAPI},
+			expected: &VTwilio{accountSID: "sid", authToken: "token", twilioNumber: "12345678910", baseAPI: baseAPI, lookupAPI: lookupAPI},
 		},
 	}
 
@@ -49,6 +49,7 @@ func TestSetPhoneNumber(t *testing.T) {
 				authToken:    "token",
 				twilioNumber: "12345678910",
 				baseAPI:      baseAPI,
+				lookupAPI:    lookupAPI,
 			},
 		},
 	}
@@ -0,0 +1,251 @@
+package vtwilio
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/twiebe-va/vtwilio-go/twiml"
+)
+
+// Webhook is one of the urls Twilio requests for a number
+type Webhook string
+
+const (
+	// SMSWebhook is requested when the number receives a message
+	SMSWebhook Webhook = "sms_url"
+	// SMSFallbackWebhook is requested when the SMS webhook fails
+	SMSFallbackWebhook Webhook = "sms_fallback_url"
+	// VoiceWebhook is requested when the number receives a call
+	VoiceWebhook Webhook = "voice_url"
+	// VoiceFallbackWebhook is requested when the voice webhook fails
+	VoiceFallbackWebhook Webhook = "voice_fallback_url"
+)
+
+const (
+	defaultWebhookCheckTimeout = 15 * time.Second
+	// WebhookProbeHeader is set on every request CheckWebhooks sends so a handler can tell a probe from real traffic
+	WebhookProbeHeader = "X-Vtwilio-Probe"
+	// webhookProbeFrom is Twilio's test number, probes come from it rather than from a real sender
+	webhookProbeFrom = "+15005550006"
+)
+
+// WebhookCheck is the result of sending a test request to one of a number's webhooks
+type WebhookCheck struct {
+	SID         string
+	Number      string
+	Webhook     Webhook
+	URL         string
+	Method      string
+	StatusCode  int
+	ContentType string
+	// Err describes why the webhook is unhealthy, it is nil for a healthy webhook
+	Err error
+}
+
+// WebhookReport is the result of checking the webhooks of the account's numbers
+type WebhookReport struct {
+	Checks []WebhookCheck
+}
+
+// Failures returns the checks of unhealthy webhooks
+func (r *WebhookReport) Failures() []WebhookCheck {
+	failures := []WebhookCheck{}
+	for _, c := range r.Checks {
+		if c.Err != nil {
+			failures = append(failures, c)
+		}
+	}
+	return failures
+}
+
+type webhookCheckConfiguration struct {
+	Client *http.Client
+	Params func(number string, webhook Webhook) url.Values
+}
+
+// WebhookCheckOption is an option for checking webhooks
+type WebhookCheckOption func(*webhookCheckConfiguration)
+
+// WebhookCheckClient sets the http client used to request webhooks, defaults to a client with a 15 second timeout
+func WebhookCheckClient(c *http.Client) WebhookCheckOption {
+	return func(w *webhookCheckConfiguration) {
+		w.Client = c
+	}
+}
+
+// WebhookCheckParams sets the parameters sent to a number's webhook, replacing the default probe parameters
+func WebhookCheckParams(params func(number string, webhook Webhook) url.Values) WebhookCheckOption {
+	return func(w *webhookCheckConfiguration) {
+		w.Params = params
+	}
+}
+
+// CheckWebhooks sends a signed test request, like the ones Twilio sends, to the SMS and voice webhooks
+// and fallbacks of every number on the account. A webhook is healthy when it responds with a 2xx status
+// and valid TwiML, a <Response> whose verbs are all TwiML verbs. An empty <Response> is valid, it tells
+// Twilio to do nothing. SMS webhooks may also respond with plain text and voice webhooks with audio.
+// Each request carries the WebhookProbeHeader header and comes from Twilio's test number +15005550006.
+func (v *VTwilio) CheckWebhooks(opts ...WebhookCheckOption) (*WebhookReport, error) {
+	c := &webhookCheckConfiguration{Client: &http.Client{Timeout: defaultWebhookCheckTimeout}, Params: v.webhookProbeParams}
+	for _, o := range opts {
+		o(c)
+	}
+
+	numbers, err := v.allIncomingPhoneNumbers()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &WebhookReport{}
+	for _, n := range numbers {
+		for _, hook := range []struct {
+			webhook Webhook
+			url     string
+			method  string
+		}{
+			{SMSWebhook, n.SMSURL, n.SMSMethod},
+			{SMSFallbackWebhook, n.SMSFallbackURL, n.SMSFallbackMethod},
+			{VoiceWebhook, n.VoiceURL, n.VoiceMethod},
+			{VoiceFallbackWebhook, n.VoiceFallbackURL, n.VoiceFallbackMethod},
+		} {
+			if hook.url == "" {
+				continue
+			}
+			check := WebhookCheck{SID: n.SID, Number: n.PhoneNumber, Webhook: hook.webhook, URL: hook.url, Method: strings.ToUpper(hook.method)}
+			if check.Method == "" {
+				check.Method = POST.String()
+			}
+			v.checkWebhook(c.Client, &check, c.Params(n.PhoneNumber, hook.webhook))
+			report.Checks = append(report.Checks, check)
+		}
+	}
+	return report, nil
+}
+
+func (v *VTwilio) checkWebhook(client *http.Client, check *WebhookCheck, params url.Values) {
+	var req *http.Request
+	var err error
+	signed := check.URL
+	switch check.Method {
+	case GET.String():
+		u, perr := url.Parse(check.URL)
+		if perr != nil {
+			check.Err = perr
+			return
+		}
+		query := u.Query()
+		for k, vals := range params {
+			query[k] = vals
+		}
+		u.RawQuery = query.Encode()
+		signed = u.String()
+		req, err = http.NewRequest("GET", signed, nil)
+		params = url.Values{}
+	case POST.String():
+		req, err = http.NewRequest("POST", check.URL, strings.NewReader(params.Encode()))
+	default:
+		err = fmt.Errorf("unsupported webhook method %v", check.Method)
+	}
+	if err != nil {
+		check.Err = err
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(WebhookProbeHeader, "webhook-check")
+	req.Header.Set(SignatureHeader, computeSignature(v.authToken, signed, params))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		check.Err = fmt.Errorf("unreachable: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		check.Err = err
+		return
+	}
+
+	check.StatusCode = resp.StatusCode
+	check.ContentType = resp.Header.Get("Content-Type")
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		check.Err = fmt.Errorf("responded with status %v", resp.StatusCode)
+		return
+	}
+	if resp.StatusCode == http.StatusNoContent && (check.Webhook == SMSWebhook || check.Webhook == SMSFallbackWebhook) {
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(check.ContentType)
+	switch {
+	case mediaType == "text/xml" || mediaType == "application/xml":
+		if _, err := twiml.Parse(body); err != nil {
+			check.Err = fmt.Errorf("invalid TwiML: %v", err)
+		} else if err := checkTwiMLVerbs(body); err != nil {
+			check.Err = fmt.Errorf("invalid TwiML: %v", err)
+		}
+	case mediaType == "text/plain" && (check.Webhook == SMSWebhook || check.Webhook == SMSFallbackWebhook):
+	case strings.HasPrefix(mediaType, "audio/") && (check.Webhook == VoiceWebhook || check.Webhook == VoiceFallbackWebhook):
+	default:
+		check.Err = fmt.Errorf("unexpected content type %q", check.ContentType)
+	}
+}
+
+// twimlVerbs are the elements Twilio accepts directly inside <Response>
+var twimlVerbs = map[string]bool{
+	"Connect": true, "Dial": true, "Echo": true, "Enqueue": true, "Gather": true, "Hangup": true, "Leave": true,
+	"Message": true, "Pause": true, "Pay": true, "Play": true, "Prompt": true, "Record": true, "Redirect": true,
+	"Refer": true, "Reject": true, "Say": true, "Sms": true, "Start": true, "Stop": true,
+}
+
+// checkTwiMLVerbs returns an error for the first element inside <Response> that is not a TwiML verb
+func checkTwiMLVerbs(body []byte) error {
+	d := xml.NewDecoder(bytes.NewReader(body))
+	depth := 0
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 && !twimlVerbs[t.Name.Local] {
+				return fmt.Errorf("unknown verb <%v>", t.Name.Local)
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+// webhookProbeParams are the default parameters of a test request, an inbound message or call to number
+// from Twilio's test number
+func (v *VTwilio) webhookProbeParams(number string, webhook Webhook) url.Values {
+	params := url.Values{}
+	params.Set("AccountSid", v.accountSID)
+	params.Set("From", webhookProbeFrom)
+	params.Set("To", number)
+	params.Set("ApiVersion", "2010-04-01")
+	if webhook == SMSWebhook || webhook == SMSFallbackWebhook {
+		params.Set("MessageSid", "SM00000000000000000000000000000000")
+		params.Set("Body", "vtwilio webhook check probe")
+		params.Set("NumMedia", "0")
+		return params
+	}
+	params.Set("CallSid", "CA00000000000000000000000000000000")
+	params.Set("CallStatus", "ringing")
+	params.Set("Direction", "inbound")
+	return params
+}
@@ -0,0 +1,132 @@
+package vtwilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckWebhooks(t *testing.T) {
+	var hooks *httptest.Server
+	hooks = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		u := hooks.URL + r.URL.Path
+		if r.Method == "GET" {
+			u = hooks.URL + r.URL.RequestURI()
+			r.PostForm = nil
+		}
+		if computeSignature("token", u, r.PostForm) != r.Header.Get(SignatureHeader) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		assert.Equal(t, "webhook-check", r.Header.Get(WebhookProbeHeader))
+		assert.Equal(t, "+15005550006", r.Form.Get("From"))
+
+		switch r.URL.Path {
+		case "/sms":
+			assert.Equal(t, "SM00000000000000000000000000000000", r.Form.Get("MessageSid"))
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, "thanks")
+		case "/voice":
+			assert.Equal(t, "GET", r.Method)
+			assert.Equal(t, "ringing", r.Form.Get("CallStatus"))
+			w.Header().Set("Content-Type", "text/xml")
+			fmt.Fprint(w, `<Response><Say>hello</Say></Response>`)
+		case "/unknown":
+			w.Header().Set("Content-Type", "text/xml")
+			fmt.Fprint(w, `<Response><Say>hello</Say><Speak>hello</Speak></Response>`)
+		case "/empty":
+			w.Header().Set("Content-Type", "text/xml")
+			fmt.Fprint(w, `<Response/>`)
+		case "/broken":
+			w.Header().Set("Content-Type", "text/xml")
+			fmt.Fprint(w, `<Response><Say>hello</Response>`)
+		case "/html":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprint(w, `<html></html>`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer hooks.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"incoming_phone_numbers": [
+			{"sid": "PN1", "phone_number": "+13065551234", "sms_url": "%[1]v/sms", "sms_method": "POST", "voice_url": "%[1]v/voice", "voice_method": "GET"},
+			{"sid": "PN2", "phone_number": "+13065554321", "sms_url": "%[1]v/html", "sms_fallback_url": "%[1]v/missing", "voice_url": "%[1]v/broken", "voice_method": "POST"},
+			{"sid": "PN3", "phone_number": "+13065555555", "sms_url": "%[1]v/empty", "voice_url": "%[1]v/unknown"}
+		]}`, hooks.URL)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	report, err := v.CheckWebhooks()
+	assert.NoError(t, err)
+	assert.Len(t, report.Checks, 7)
+
+	type result struct {
+		sid     string
+		webhook Webhook
+		status  int
+		err     string
+	}
+	actual := []result{}
+	for _, c := range report.Checks {
+		r := result{sid: c.SID, webhook: c.Webhook, status: c.StatusCode}
+		if c.Err != nil {
+			r.err = c.Err.Error()
+		}
+		actual = append(actual, r)
+	}
+	assert.Equal(t, []result{
+		{"PN1", SMSWebhook, 200, ""},
+		{"PN1", VoiceWebhook, 200, ""},
+		{"PN2", SMSWebhook, 200, `unexpected content type "text/html"`},
+		{"PN2", SMSFallbackWebhook, 404, "responded with status 404"},
+		{"PN2", VoiceWebhook, 200, "invalid TwiML: XML syntax error on line 1: element <Say> closed by </Response>"},
+		{"PN3", SMSWebhook, 200, ""},
+		{"PN3", VoiceWebhook, 200, "invalid TwiML: unknown verb <Speak>"},
+	}, actual)
+	assert.Len(t, report.Failures(), 4)
+}
+
+func TestCheckWebhooksParams(t *testing.T) {
+	var form url.Values
+	hooks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		form = r.PostForm
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer hooks.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"incoming_phone_numbers": [{"sid": "PN1", "phone_number": "+13065551234", "sms_url": "%v/sms"}]}`, hooks.URL)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	report, err := v.CheckWebhooks(WebhookCheckParams(func(number string, webhook Webhook) url.Values {
+		return url.Values{"To": {number}, "Body": {string(webhook)}}
+	}))
+	assert.NoError(t, err)
+	assert.Empty(t, report.Failures())
+	assert.Equal(t, url.Values{"To": {"+13065551234"}, "Body": {"sms_url"}}, form)
+}
+
+func TestCheckWebhooksUnreachable(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"incoming_phone_numbers": [{"sid": "PN1", "phone_number": "+13065551234", "voice_url": "http://127.0.0.1:1/voice"}]}`)
+	}))
+	defer ts.Close()
+
+	v := &VTwilio{accountSID: "sid", authToken: "token", baseAPI: fmt.Sprintf("%s/", ts.URL)}
+	report, err := v.CheckWebhooks(WebhookCheckClient(http.DefaultClient))
+	assert.NoError(t, err)
+	assert.Len(t, report.Failures(), 1)
+	assert.Equal(t, "POST", report.Checks[0].Method)
+	assert.Contains(t, report.Checks[0].Err.Error(), "unreachable")
+}
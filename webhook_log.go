@@ -0,0 +1,356 @@
+package vtwilio
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWebhookLogMaxSize    = 100 << 20
+	defaultWebhookLogMaxBackups = 5
+)
+
+// WebhookLogEntry is a webhook request recorded by a WebhookLog
+type WebhookLogEntry struct {
+	Time      time.Time   `json:"time"`
+	Method    string      `json:"method"`
+	URL       string      `json:"url"`
+	Header    http.Header `json:"header"`
+	Form      url.Values  `json:"form,omitempty"`
+	Body      string      `json:"body,omitempty"`
+	Signature string      `json:"signature,omitempty"`
+}
+
+// WebhookLog appends every webhook request to a JSON lines file so the requests can be replayed. When the file
+// reaches its maximum size it is renamed to path.1, older files are renamed to path.2 and so on.
+type WebhookLog struct {
+	mu         sync.Mutex
+	path       string
+	file       *os.File
+	size       int64
+	maxSize    int64
+	maxBackups int
+	onError    func(error)
+	now        func() time.Time
+}
+
+// WebhookLogOption is an option for a webhook log
+type WebhookLogOption func(*WebhookLog)
+
+// LogMaxSize is the size in bytes a log file may grow to before it is rotated, defaults to 100MB
+func LogMaxSize(bytes int64) WebhookLogOption {
+	return func(l *WebhookLog) {
+		l.maxSize = bytes
+	}
+}
+
+// LogMaxBackups is how many rotated files are kept, defaults to 5
+func LogMaxBackups(n int) WebhookLogOption {
+	return func(l *WebhookLog) {
+		l.maxBackups = n
+	}
+}
+
+// LogOnError is called when the middleware fails to record a request, the request is still handled
+func LogOnError(f func(error)) WebhookLogOption {
+	return func(l *WebhookLog) {
+		l.onError = f
+	}
+}
+
+// LogClock overrides the clock used to time entries
+func LogClock(now func() time.Time) WebhookLogOption {
+	return func(l *WebhookLog) {
+		l.now = now
+	}
+}
+
+// NewWebhookLog opens, or creates, the log file at path
+func NewWebhookLog(path string, opts ...WebhookLogOption) (*WebhookLog, error) {
+	l := &WebhookLog{
+		path:       path,
+		maxSize:    defaultWebhookLogMaxSize,
+		maxBackups: defaultWebhookLogMaxBackups,
+		now:        time.Now,
+	}
+	for _, o := range opts {
+		o(l)
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// redactedHeaders are credentials that are never written to the log
+var redactedHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization"}
+
+// replaySkippedHeaders are not copied to a replayed request, they describe the original connection and body
+var replaySkippedHeaders = map[string]bool{
+	"Connection":          true,
+	"Content-Length":      true,
+	"Keep-Alive":          true,
+	"Proxy-Authenticate":  true,
+	"Proxy-Authorization": true,
+	"Te":                  true,
+	"Trailer":             true,
+	"Transfer-Encoding":   true,
+	"Upgrade":             true,
+}
+
+// Record appends a request to the log. The body is read and replaced so handlers can still read it.
+// Authorization, Cookie and Proxy-Authorization headers are left out of the entry.
+// When the log can not be rotated the entry is still written and the rotation error is returned.
+func (l *WebhookLog) Record(r *http.Request) error {
+	e := WebhookLogEntry{
+		Method:    r.Method,
+		URL:       requestedURL(r),
+		Header:    r.Header.Clone(),
+		Signature: r.Header.Get(SignatureHeader),
+	}
+	for _, h := range redactedHeaders {
+		e.Header.Del(h)
+	}
+
+	if r.Body != nil {
+		body, err := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if mediaType == "application/x-www-form-urlencoded" {
+			if e.Form, err = url.ParseQuery(string(body)); err != nil {
+				return err
+			}
+		} else if len(body) > 0 {
+			e.Body = string(body)
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e.Time = l.now()
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	var rotateErr error
+	if l.size > 0 && l.size+int64(len(line)) > l.maxSize {
+		rotateErr = l.rotate()
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return rotateErr
+}
+
+// Middleware records each request before passing it to next
+func (l *WebhookLog) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := l.Record(r); err != nil && l.onError != nil {
+			l.onError(err)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Close closes the log file
+func (l *WebhookLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+func (l *WebhookLog) open() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	l.file = f
+	l.size = info.Size()
+	return nil
+}
+
+// rotate moves the log to the first backup and starts a new file. The file is moved while it is still
+// open, so when rotating fails the log keeps writing to it and rotation is tried again on the next entry.
+func (l *WebhookLog) rotate() error {
+	if l.maxBackups <= 0 {
+		if err := os.Remove(l.path); err != nil {
+			return err
+		}
+	} else {
+		os.Remove(backupPath(l.path, l.maxBackups))
+		for i := l.maxBackups - 1; i >= 1; i-- {
+			if err := os.Rename(backupPath(l.path, i), backupPath(l.path, i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if err := os.Rename(l.path, backupPath(l.path, 1)); err != nil {
+			return err
+		}
+	}
+
+	rotated := l.file
+	if err := l.open(); err != nil {
+		return err
+	}
+	return rotated.Close()
+}
+
+func backupPath(path string, n int) string {
+	return fmt.Sprintf("%v.%v", path, n)
+}
+
+// requestedURL returns the url a request was received on
+func requestedURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
+
+// ReadWebhookLog reads the entries of a log file
+func ReadWebhookLog(path string) ([]WebhookLogEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries := []WebhookLogEntry{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 10<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		e := WebhookLogEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("line %v: %v", line, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
+
+// ReplayResult is the response of a handler to a replayed entry
+type ReplayResult struct {
+	Entry      WebhookLogEntry
+	StatusCode int
+	Body       string
+}
+
+type replayConfiguration struct {
+	AuthToken string
+	BaseURL   string
+}
+
+// ReplayOption is an option for replaying webhooks
+type ReplayOption func(*replayConfiguration)
+
+// ReplaySignature signs each replayed request with authToken instead of sending the recorded signature.
+// The signature is for the entry's url, or for baseURL followed by the entry's path and query when baseURL
+// is set, it should match how the handler's RequestValidator rebuilds the url.
+func ReplaySignature(authToken, baseURL string) ReplayOption {
+	return func(c *replayConfiguration) {
+		c.AuthToken = authToken
+		c.BaseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// ReplayWebhooks sends recorded entries to a handler, in order, and returns its responses
+func ReplayWebhooks(entries []WebhookLogEntry, h http.Handler, opts ...ReplayOption) ([]ReplayResult, error) {
+	c := &replayConfiguration{}
+	for _, o := range opts {
+		o(c)
+	}
+
+	results := make([]ReplayResult, 0, len(entries))
+	for _, e := range entries {
+		u, err := url.Parse(e.URL)
+		if err != nil {
+			return results, err
+		}
+
+		body := e.Body
+		if e.Form != nil {
+			body = e.Form.Encode()
+		}
+		r, err := http.NewRequest(e.Method, e.URL, strings.NewReader(body))
+		if err != nil {
+			return results, err
+		}
+		r.RequestURI = u.RequestURI()
+		if u.Scheme == "https" {
+			r.TLS = &tls.ConnectionState{}
+		}
+		for k, v := range e.Header {
+			if replaySkippedHeaders[http.CanonicalHeaderKey(k)] {
+				continue
+			}
+			r.Header[k] = append([]string{}, v...)
+		}
+
+		if c.AuthToken != "" {
+			signed := e.URL
+			if c.BaseURL != "" {
+				signed = c.BaseURL + u.RequestURI()
+			}
+			r.Header.Set(SignatureHeader, computeSignature(c.AuthToken, signed, e.Form))
+		}
+
+		w := &replayRecorder{header: http.Header{}}
+		h.ServeHTTP(w, r)
+		if w.code == 0 {
+			w.code = http.StatusOK
+		}
+		results = append(results, ReplayResult{Entry: e, StatusCode: w.code, Body: w.body.String()})
+	}
+	return results, nil
+}
+
+// replayRecorder keeps the response a handler writes for a replayed request
+type replayRecorder struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (w *replayRecorder) Header() http.Header {
+	return w.header
+}
+
+func (w *replayRecorder) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *replayRecorder) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
@@ -0,0 +1,203 @@
+package vtwilio
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func webhookRequest(target string, form url.Values, token string) *http.Request {
+	r := httptest.NewRequest("POST", target, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set(SignatureHeader, computeSignature(token, target, form))
+	return r
+}
+
+func TestWebhookLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhooklog")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Date(2023, time.October, 19, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(dir, "webhooks.jsonl")
+	l, err := NewWebhookLog(path, LogClock(func() time.Time { return now }))
+	assert.NoError(t, err)
+
+	var received []string
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		received = append(received, r.PostForm.Get("Body"))
+	}))
+
+	form := url.Values{"MessageSid": {"SM1"}, "From": {"+13065551234"}, "Body": {"hello"}}
+	handler.ServeHTTP(httptest.NewRecorder(), webhookRequest("http://example.com/sms", form, "token"))
+	jsonReq := httptest.NewRequest("POST", "http://example.com/events?bodySHA256=abc", strings.NewReader(`{"a": 1}`))
+	jsonReq.Header.Set("Content-Type", "application/json")
+	handler.ServeHTTP(httptest.NewRecorder(), jsonReq)
+	assert.NoError(t, l.Close())
+	assert.Equal(t, []string{"hello", ""}, received)
+
+	entries, err := ReadWebhookLog(path)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, now, entries[0].Time)
+	assert.Equal(t, "POST", entries[0].Method)
+	assert.Equal(t, "http://example.com/sms", entries[0].URL)
+	assert.Equal(t, form, entries[0].Form)
+	assert.Equal(t, computeSignature("token", "http://example.com/sms", form), entries[0].Signature)
+	assert.Equal(t, "application/x-www-form-urlencoded", entries[0].Header.Get("Content-Type"))
+	assert.Equal(t, `{"a": 1}`, entries[1].Body)
+	assert.Nil(t, entries[1].Form)
+}
+
+func TestWebhookLogRedactsCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhooklog")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "webhooks.jsonl")
+	l, err := NewWebhookLog(path)
+	assert.NoError(t, err)
+
+	r := webhookRequest("http://example.com/sms", url.Values{"Body": {"hello"}}, "token")
+	r.SetBasicAuth("user", "secret")
+	r.Header.Set("Cookie", "session=secret")
+	r.Header.Set("Proxy-Authorization", "Basic secret")
+	assert.NoError(t, l.Record(r))
+	assert.NoError(t, l.Close())
+	assert.NotEmpty(t, r.Header.Get("Authorization"), "the request keeps its headers")
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+	entries, err := ReadWebhookLog(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/x-www-form-urlencoded", entries[0].Header.Get("Content-Type"))
+}
+
+func TestWebhookLogRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhooklog")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "webhooks.jsonl")
+	l, err := NewWebhookLog(path, LogMaxSize(1), LogMaxBackups(2))
+	assert.NoError(t, err)
+
+	for _, body := range []string{"1", "2", "3", "4"} {
+		assert.NoError(t, l.Record(webhookRequest("http://example.com/sms", url.Values{"Body": {body}}, "token")))
+	}
+	assert.NoError(t, l.Close())
+
+	for file, expected := range map[string]string{path: "4", path + ".1": "3", path + ".2": "2"} {
+		entries, err := ReadWebhookLog(file)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, expected, entries[0].Form.Get("Body"))
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWebhookLogRotationFails(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhooklog")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "webhooks.jsonl")
+	l, err := NewWebhookLog(path, LogMaxSize(1), LogMaxBackups(1))
+	assert.NoError(t, err)
+	defer l.Close()
+
+	// a directory in the way of the backup stops the log being moved
+	assert.NoError(t, os.MkdirAll(filepath.Join(path+".1", "blocked"), 0700))
+	assert.NoError(t, l.Record(webhookRequest("http://example.com/sms", url.Values{"Body": {"1"}}, "token")))
+	assert.Error(t, l.Record(webhookRequest("http://example.com/sms", url.Values{"Body": {"2"}}, "token")))
+
+	assert.NoError(t, os.RemoveAll(path+".1"))
+	assert.NoError(t, l.Record(webhookRequest("http://example.com/sms", url.Values{"Body": {"3"}}, "token")))
+
+	backup, err := ReadWebhookLog(path + ".1")
+	assert.NoError(t, err)
+	assert.Len(t, backup, 2, "the entry is written when rotation fails")
+	current, err := ReadWebhookLog(path)
+	assert.NoError(t, err)
+	assert.Len(t, current, 1)
+	assert.Equal(t, "3", current[0].Form.Get("Body"))
+}
+
+func TestReplayWebhooksRebuiltBody(t *testing.T) {
+	entry := WebhookLogEntry{
+		Method: "POST",
+		URL:    "http://example.com/sms",
+		Header: http.Header{
+			"Content-Type":      {"application/x-www-form-urlencoded"},
+			"Content-Length":    {"3"},
+			"Connection":        {"close"},
+			"Transfer-Encoding": {"chunked"},
+			"X-Custom":          {"kept"},
+		},
+		Form: url.Values{"Body": {"a longer body than the recorded length"}},
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Content-Length"))
+		assert.Empty(t, r.Header.Get("Connection"))
+		assert.Empty(t, r.Header.Get("Transfer-Encoding"))
+		assert.Equal(t, "kept", r.Header.Get("X-Custom"))
+		r.ParseForm()
+		assert.Equal(t, "a longer body than the recorded length", r.PostForm.Get("Body"))
+	})
+	_, err := ReplayWebhooks([]WebhookLogEntry{entry}, handler)
+	assert.NoError(t, err)
+}
+
+func TestReplayWebhooksInvalidEntry(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	results, err := ReplayWebhooks([]WebhookLogEntry{
+		{Method: "POST", URL: "http://example.com/sms"},
+		{Method: "BAD METHOD", URL: "http://example.com/sms"},
+	}, handler)
+	assert.Error(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, http.StatusOK, results[0].StatusCode)
+}
+
+func TestReplayWebhooks(t *testing.T) {
+	form := url.Values{"MessageSid": {"SM1"}, "From": {"+13065551234"}, "To": {"+13065559999"}, "Body": {"hello"}}
+	entries := []WebhookLogEntry{
+		{
+			Method:    "POST",
+			URL:       "http://10.0.0.1:8080/sms",
+			Header:    http.Header{"Content-Type": {"application/x-www-form-urlencoded"}, SignatureHeader: {"old signature"}},
+			Form:      form,
+			Signature: "old signature",
+		},
+	}
+
+	var received []*IncomingMessage
+	validator := NewRequestValidator("new token", ValidatorBaseURL("https://example.com"))
+	handler := validator.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m, err := ParseIncomingMessage(r)
+		assert.NoError(t, err)
+		received = append(received, m)
+	}))
+
+	results, err := ReplayWebhooks(entries, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, results[0].StatusCode)
+	assert.Len(t, received, 0)
+
+	results, err = ReplayWebhooks(entries, handler, ReplaySignature("new token", "https://example.com/"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, results[0].StatusCode)
+	assert.Len(t, received, 1)
+	assert.Equal(t, "hello", received[0].Body)
+}